@@ -0,0 +1,168 @@
+package apperror
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+// Kind classifies errors by what the client can do about them, independently of the transport.
+type Kind int
+
+const (
+	KindValidation Kind = iota + 1
+	KindNotFound
+	KindConflict
+	KindFailedPrecondition
+	KindPermissionDenied
+	KindInternal
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindValidation:
+		return "validation"
+	case KindNotFound:
+		return "not found"
+	case KindConflict:
+		return "conflict"
+	case KindFailedPrecondition:
+		return "failed precondition"
+	case KindPermissionDenied:
+		return "permission denied"
+	case KindInternal:
+		return "internal"
+	default:
+		return "unknown"
+	}
+}
+
+// FieldViolation describes why the value of a field in the request is invalid.
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// Error is an error with a kind and a stable reason which clients can rely on, independently of the transport.
+// message is safe to show to clients, while cause is for logs only and must never be sent to clients.
+// it records where it was created, which is printed with the causes by "%+v".
+type Error struct {
+	kind       Kind
+	reason     Reason
+	message    string
+	violations []*FieldViolation
+	cause      error
+	frame      xerrors.Frame
+}
+
+// newError must be called directly by the exported constructors, so that the frame is the caller of them.
+func newError(kind Kind, reason Reason, message string, violations []*FieldViolation, cause error) *Error {
+	return &Error{
+		kind:       kind,
+		reason:     reason,
+		message:    message,
+		violations: violations,
+		cause:      cause,
+		frame:      xerrors.Caller(2),
+	}
+}
+
+func (e *Error) Error() string {
+	if e.cause != nil {
+		return fmt.Sprintf("%s: %v", e.message, e.cause)
+	}
+
+	return e.message
+}
+
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+func (e *Error) Format(s fmt.State, v rune) {
+	xerrors.FormatError(e, s, v)
+}
+
+func (e *Error) FormatError(p xerrors.Printer) error {
+	p.Print(e.message)
+	e.frame.Format(p)
+
+	return e.cause
+}
+
+func (e *Error) Kind() Kind {
+	return e.kind
+}
+
+func (e *Error) Reason() Reason {
+	return e.reason
+}
+
+func (e *Error) Message() string {
+	return e.message
+}
+
+func (e *Error) FieldViolations() []*FieldViolation {
+	return e.violations
+}
+
+// NewValidationError reports a single violation of the field, such as "user_id".
+func NewValidationError(field, format string, args ...interface{}) *Error {
+	description := fmt.Sprintf(format, args...)
+
+	return newError(KindValidation, ReasonInvalidArgument, description, []*FieldViolation{{Field: field, Description: description}}, nil)
+}
+
+// NewFieldError reports the error of a value object as the violations of the field.
+// if err has Violations() []string, such as vo.ValidationError, each of them becomes a violation.
+func NewFieldError(field string, err error) *Error {
+	descriptions := []string{err.Error()}
+
+	var violationsErr interface{ Violations() []string }
+	if xerrors.As(err, &violationsErr) {
+		descriptions = violationsErr.Violations()
+	}
+
+	violations := make([]*FieldViolation, len(descriptions))
+	for i, description := range descriptions {
+		violations[i] = &FieldViolation{Field: field, Description: description}
+	}
+
+	return newError(KindValidation, ReasonInvalidArgument, fmt.Sprintf("invalid %s: %v", strings.ReplaceAll(field, "_", " "), err), violations, nil)
+}
+
+func NewNotFoundError(reason Reason, format string, args ...interface{}) *Error {
+	return newError(KindNotFound, reason, fmt.Sprintf(format, args...), nil, nil)
+}
+
+func NewConflictError(reason Reason, format string, args ...interface{}) *Error {
+	return newError(KindConflict, reason, fmt.Sprintf(format, args...), nil, nil)
+}
+
+func NewFailedPreconditionError(reason Reason, format string, args ...interface{}) *Error {
+	return newError(KindFailedPrecondition, reason, fmt.Sprintf(format, args...), nil, nil)
+}
+
+func NewPermissionDeniedError(reason Reason, format string, args ...interface{}) *Error {
+	return newError(KindPermissionDenied, reason, fmt.Sprintf(format, args...), nil, nil)
+}
+
+// NewInternalError hides the cause from clients, such as errors of the database.
+func NewInternalError(cause error) *Error {
+	return newError(KindInternal, ReasonInternal, "internal error", nil, cause)
+}
+
+// KindOf returns KindInternal for errors which are not *Error, since their causes are unknown.
+func KindOf(err error) Kind {
+	var appErr *Error
+	if xerrors.As(err, &appErr) {
+		return appErr.kind
+	}
+
+	return KindInternal
+}
+
+func Is(err error, kind Kind) bool {
+	return err != nil && KindOf(err) == kind
+}
@@ -0,0 +1,37 @@
+package apperror
+
+// Reason is a stable UPPER_SNAKE_CASE identifier of an error. the values must not be changed once released,
+// since clients branch on them.
+type Reason string
+
+const (
+	ReasonInvalidArgument Reason = "INVALID_ARGUMENT"
+	ReasonInternal        Reason = "INTERNAL"
+
+	ReasonUserNotFound                 Reason = "USER_NOT_FOUND"
+	ReasonStandardBudgetsNotFound      Reason = "STANDARD_BUDGETS_NOT_FOUND"
+	ReasonCustomBudgetsNotFound        Reason = "CUSTOM_BUDGETS_NOT_FOUND"
+	ReasonGroupStandardBudgetsNotFound Reason = "GROUP_STANDARD_BUDGETS_NOT_FOUND"
+	ReasonCustomCategoryNotFound       Reason = "CUSTOM_CATEGORY_NOT_FOUND"
+	ReasonTransactionNotFound          Reason = "TRANSACTION_NOT_FOUND"
+	ReasonGroupNotFound                Reason = "GROUP_NOT_FOUND"
+	ReasonGroupUserNotFound            Reason = "GROUP_USER_NOT_FOUND"
+	ReasonGroupTransactionNotFound     Reason = "GROUP_TRANSACTION_NOT_FOUND"
+	ReasonRecurringTransactionNotFound Reason = "RECURRING_TRANSACTION_NOT_FOUND"
+	ReasonTodoNotFound                 Reason = "TODO_NOT_FOUND"
+	ReasonShoppingItemNotFound         Reason = "SHOPPING_ITEM_NOT_FOUND"
+
+	ReasonUserAlreadyExists                   Reason = "USER_ALREADY_EXISTS"
+	ReasonUserIDAlreadyExists                 Reason = "USER_ID_ALREADY_EXISTS"
+	ReasonEmailAlreadyExists                  Reason = "EMAIL_ALREADY_EXISTS"
+	ReasonCustomBudgetsAlreadyExist           Reason = "CUSTOM_BUDGETS_ALREADY_EXIST"
+	ReasonCategoryNameAlreadyExists           Reason = "CATEGORY_NAME_ALREADY_EXISTS"
+	ReasonGroupUserAlreadyExists              Reason = "GROUP_USER_ALREADY_EXISTS"
+	ReasonGroupInvitationAlreadyExists        Reason = "GROUP_INVITATION_ALREADY_EXISTS"
+	ReasonRecurringTransactionAlreadyExecuted Reason = "RECURRING_TRANSACTION_ALREADY_EXECUTED"
+
+	ReasonShoppingItemAlreadyPurchased Reason = "SHOPPING_ITEM_ALREADY_PURCHASED"
+	ReasonLastGroupMember              Reason = "LAST_GROUP_MEMBER"
+
+	ReasonNotGroupMember Reason = "NOT_GROUP_MEMBER"
+)
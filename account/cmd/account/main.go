@@ -1,13 +1,16 @@
 package main
 
 import (
-	"log"
+	"fmt"
+	"os"
 
 	"github.com/paypay3/tukecholl-api/account/infrastructure/server"
+	"github.com/paypay3/tukecholl-api/account/logger"
 )
 
 func main() {
 	if err := server.Run(); err != nil {
-		log.Fatalf("%+v", err)
+		logger.Error("server stopped", "error", fmt.Sprintf("%+v", err))
+		os.Exit(1)
 	}
 }
@@ -1,11 +1,13 @@
 package config
 
 import (
-	"log"
+	"fmt"
 	"os"
 	"time"
 
 	"github.com/kelseyhightower/envconfig"
+
+	"github.com/paypay3/tukecholl-api/account/logger"
 )
 
 var Env ENV
@@ -14,22 +16,52 @@ func init() {
 	env := os.Getenv("GO_ENV")
 
 	if err := envconfig.Process(env, &Env); err != nil {
-		log.Fatalf("%+v", err)
+		logger.Error("failed to load the environment variables", "error", fmt.Sprintf("%+v", err))
+		os.Exit(1)
 	}
 }
 
 type ENV struct {
 	Server
 	RDB
+	Scheduler
+	Validation
+	Interceptor
+	Log
 }
 
 type Server struct {
 	Port int `envconfig:"SERVER_PORT" required:"true"`
 }
 
+// RDB logs the queries which take longer than SlowQueryThreshold, and disables the logs if the threshold is 0.
 type RDB struct {
-	Dsn             string        `envconfig:"MYSQL_DSN"               required:"true"`
-	MaxConn         int           `envconfig:"MYSQL_MAX_CONN"          default:"25"`
-	MaxIdleConn     int           `envconfig:"MYSQL_MAX_IDLE"          default:"25"`
-	MaxConnLifetime time.Duration `envconfig:"MYSQL_MAX_CONN_LIFETIME" default:"300s"`
+	Dsn                string        `envconfig:"MYSQL_DSN"                  required:"true"`
+	MaxConn            int           `envconfig:"MYSQL_MAX_CONN"             default:"25"`
+	MaxIdleConn        int           `envconfig:"MYSQL_MAX_IDLE"             default:"25"`
+	MaxConnLifetime    time.Duration `envconfig:"MYSQL_MAX_CONN_LIFETIME"    default:"300s"`
+	SlowQueryThreshold time.Duration `envconfig:"MYSQL_SLOW_QUERY_THRESHOLD" default:"200ms"`
+}
+
+// Scheduler disables the timer of recurring transactions if the interval is 0.
+type Scheduler struct {
+	RecurringTransactionInterval time.Duration `envconfig:"RECURRING_TRANSACTION_INTERVAL" default:"1h"`
+}
+
+// Validation keeps the default characters allowed in user ids if UserIDPattern is empty.
+type Validation struct {
+	UserIDPattern string `envconfig:"USER_ID_PATTERN"`
+}
+
+// Interceptor lists the names of the unary interceptors in the order they are chained, outermost first.
+// the available names are requestid, logging, recovery, timeout, validation and auth, and auth requires APIKeys.
+type Interceptor struct {
+	Interceptors []string      `envconfig:"INTERCEPTORS"    default:"requestid,logging,recovery,timeout,validation"`
+	Timeout      time.Duration `envconfig:"REQUEST_TIMEOUT" default:"10s"`
+	APIKeys      []string      `envconfig:"API_KEYS"`
+}
+
+// Log sets the lowest level of the logs written, which is one of debug, info, warn and error.
+type Log struct {
+	Level string `envconfig:"LOG_LEVEL" default:"info"`
 }
@@ -2,6 +2,18 @@ DROP DATABASE IF EXISTS test_db;
 CREATE DATABASE test_db;
 USE test_db;
 
+CREATE TABLE users
+(
+  id VARCHAR(10) NOT NULL,
+  name VARCHAR(50) NOT NULL,
+  email VARCHAR(256) NOT NULL,
+  password VARCHAR(255) NOT NULL,
+  created_date DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_date DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  PRIMARY KEY(id),
+  UNIQUE uq_email(email)
+);
+
 CREATE TABLE transaction_types
 (
   id INT NOT NULL AUTO_INCREMENT,
@@ -98,6 +110,84 @@ CREATE TABLE custom_budgets
     ON DELETE RESTRICT ON UPDATE CASCADE
 );
 
+CREATE TABLE recurring_transactions
+(
+  id INT NOT NULL AUTO_INCREMENT,
+  transaction_type_id INT NOT NULL,
+  shop VARCHAR(20) DEFAULT NULL,
+  memo VARCHAR(50) DEFAULT NULL,
+  amount INT NOT NULL,
+  user_id VARCHAR(10) NOT NULL,
+  big_category_id INT NOT NULL,
+  medium_category_id INT DEFAULT NULL,
+  custom_category_id INT DEFAULT NULL,
+  recurrence_type_id INT NOT NULL,
+  day_of_month INT DEFAULT NULL,
+  day_of_week INT DEFAULT NULL,
+  start_date DATE NOT NULL,
+  end_date DATE DEFAULT NULL,
+  PRIMARY KEY(id),
+  FOREIGN KEY fk_transaction_type_id(transaction_type_id)
+    REFERENCES transaction_types(id)
+    ON DELETE RESTRICT ON UPDATE CASCADE,
+  FOREIGN KEY fk_big_category_id(big_category_id)
+    REFERENCES big_categories(id)
+    ON DELETE RESTRICT ON UPDATE CASCADE,
+  FOREIGN KEY fk_medium_category_id(medium_category_id)
+    REFERENCES medium_categories(id)
+    ON DELETE RESTRICT ON UPDATE CASCADE,
+  FOREIGN KEY fk_custom_category_id(custom_category_id)
+    REFERENCES custom_categories(id)
+    ON DELETE SET NULL ON UPDATE CASCADE,
+  INDEX idx_user_id(user_id)
+);
+
+CREATE TABLE recurring_transaction_executions
+(
+  recurring_transaction_id INT NOT NULL,
+  execution_date DATE NOT NULL,
+  transaction_id INT NOT NULL,
+  PRIMARY KEY(recurring_transaction_id, execution_date),
+  FOREIGN KEY fk_recurring_transaction_id(recurring_transaction_id)
+    REFERENCES recurring_transactions(id)
+    ON DELETE CASCADE ON UPDATE CASCADE
+);
+
+CREATE TABLE group_names
+(
+  id INT NOT NULL AUTO_INCREMENT,
+  group_name VARCHAR(20) NOT NULL,
+  PRIMARY KEY(id)
+);
+
+CREATE TABLE group_users
+(
+  group_id INT NOT NULL,
+  user_id VARCHAR(10) NOT NULL,
+  PRIMARY KEY(group_id, user_id),
+  FOREIGN KEY fk_group_id(group_id)
+    REFERENCES group_names(id)
+    ON DELETE CASCADE ON UPDATE CASCADE,
+  FOREIGN KEY fk_user_id(user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE ON UPDATE CASCADE,
+  INDEX idx_user_id(user_id)
+);
+
+CREATE TABLE group_unapproved_users
+(
+  group_id INT NOT NULL,
+  user_id VARCHAR(10) NOT NULL,
+  PRIMARY KEY(group_id, user_id),
+  FOREIGN KEY fk_group_id(group_id)
+    REFERENCES group_names(id)
+    ON DELETE CASCADE ON UPDATE CASCADE,
+  FOREIGN KEY fk_user_id(user_id)
+    REFERENCES users(id)
+    ON DELETE CASCADE ON UPDATE CASCADE,
+  INDEX idx_user_id(user_id)
+);
+
 CREATE TABLE group_custom_categories
 (
   id INT NOT NULL AUTO_INCREMENT,
@@ -105,7 +195,7 @@ CREATE TABLE group_custom_categories
   big_category_id INT NOT NULL,
   group_id INT NOT NULL,
   PRIMARY KEY(id),
-  UNIQUE uq_group_custom_category(user_id, big_category_id, category_name),
+  UNIQUE uq_group_custom_category(group_id, big_category_id, category_name),
   FOREIGN KEY fk_big_category_id(big_category_id)
     REFERENCES big_categories(id)
     ON DELETE RESTRICT ON UPDATE CASCADE
@@ -180,3 +270,54 @@ CREATE TABLE group_accounts
   PRIMARY KEY(id),
   UNIQUE uq_group_accounts(group_id, years_months, payer_user_id, recipient_user_id)
 );
+
+CREATE TABLE group_todos
+(
+  id INT NOT NULL AUTO_INCREMENT,
+  posted_date DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_date DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  implementation_date DATE NOT NULL,
+  due_date DATE NOT NULL,
+  todo_content VARCHAR(100) NOT NULL,
+  completed TINYINT(1) NOT NULL DEFAULT 0,
+  group_id INT NOT NULL,
+  posted_user_id VARCHAR(10) NOT NULL,
+  assignee_user_id VARCHAR(10) DEFAULT NULL,
+  PRIMARY KEY(id),
+  FOREIGN KEY fk_group_id(group_id)
+    REFERENCES group_names(id)
+    ON DELETE CASCADE ON UPDATE CASCADE,
+  INDEX idx_group_id_due_date(group_id, due_date)
+);
+
+CREATE TABLE group_shopping_items
+(
+  id INT NOT NULL AUTO_INCREMENT,
+  posted_date DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  updated_date DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+  expected_purchase_date DATE NOT NULL,
+  item_name VARCHAR(50) NOT NULL,
+  shop VARCHAR(20) DEFAULT NULL,
+  amount INT DEFAULT NULL,
+  completed TINYINT(1) NOT NULL DEFAULT 0,
+  group_id INT NOT NULL,
+  posted_user_id VARCHAR(10) NOT NULL,
+  assignee_user_id VARCHAR(10) DEFAULT NULL,
+  big_category_id INT NOT NULL,
+  medium_category_id INT DEFAULT NULL,
+  transaction_id INT DEFAULT NULL,
+  PRIMARY KEY(id),
+  FOREIGN KEY fk_group_id(group_id)
+    REFERENCES group_names(id)
+    ON DELETE CASCADE ON UPDATE CASCADE,
+  FOREIGN KEY fk_big_category_id(big_category_id)
+    REFERENCES big_categories(id)
+    ON DELETE RESTRICT ON UPDATE CASCADE,
+  FOREIGN KEY fk_medium_category_id(medium_category_id)
+    REFERENCES medium_categories(id)
+    ON DELETE RESTRICT ON UPDATE CASCADE,
+  FOREIGN KEY fk_transaction_id(transaction_id)
+    REFERENCES group_transactions(id)
+    ON DELETE SET NULL ON UPDATE CASCADE,
+  INDEX idx_group_id_expected_purchase_date(group_id, expected_purchase_date)
+);
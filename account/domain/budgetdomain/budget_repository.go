@@ -1,7 +1,23 @@
 package budgetdomain
 
-import "github.com/paypay3/tukecholl-api/account/domain/vo"
+import (
+	"context"
+
+	"github.com/paypay3/tukecholl-api/account/domain/vo"
+)
 
 type Repository interface {
-	CreateStandardBudgets(userID vo.UserID) error
+	// CreateStandardBudgets creates only the standard budgets which do not exist yet, and keeps the existing ones as they are.
+	CreateStandardBudgets(ctx context.Context, userID vo.UserID, bigCategoryIDs []vo.BigCategoryID) error
+	GetStandardBudgets(ctx context.Context, userID vo.UserID) ([]*StandardBudget, error)
+	EditStandardBudgets(ctx context.Context, userID vo.UserID, standardBudgets []*StandardBudget) error
+	CreateCustomBudgets(ctx context.Context, userID vo.UserID, yearMonth vo.YearMonth, customBudgets []*CustomBudget) error
+	GetCustomBudgets(ctx context.Context, userID vo.UserID, yearMonth vo.YearMonth) ([]*CustomBudget, error)
+	EditCustomBudgets(ctx context.Context, userID vo.UserID, yearMonth vo.YearMonth, customBudgets []*CustomBudget) error
+	DeleteCustomBudgets(ctx context.Context, userID vo.UserID, yearMonth vo.YearMonth) error
+	GetMonthlyCustomBudgetsList(ctx context.Context, userID vo.UserID, from, to vo.YearMonth) ([]*MonthlyCustomBudgets, error)
+	// CreateGroupStandardBudgets creates only the standard budgets which do not exist yet, and keeps the existing ones as they are.
+	CreateGroupStandardBudgets(ctx context.Context, groupID int, bigCategoryIDs []vo.BigCategoryID) error
+	GetGroupStandardBudgets(ctx context.Context, groupID int) ([]*StandardBudget, error)
+	EditGroupStandardBudgets(ctx context.Context, groupID int, standardBudgets []*StandardBudget) error
 }
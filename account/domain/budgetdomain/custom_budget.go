@@ -0,0 +1,29 @@
+package budgetdomain
+
+import "github.com/paypay3/tukecholl-api/account/domain/vo"
+
+type CustomBudget struct {
+	bigCategoryID   vo.BigCategoryID
+	bigCategoryName string
+	budget          vo.BudgetAmount
+}
+
+func NewCustomBudget(bigCategoryID vo.BigCategoryID, bigCategoryName string, budget vo.BudgetAmount) *CustomBudget {
+	return &CustomBudget{
+		bigCategoryID:   bigCategoryID,
+		bigCategoryName: bigCategoryName,
+		budget:          budget,
+	}
+}
+
+func (b *CustomBudget) BigCategoryID() vo.BigCategoryID {
+	return b.bigCategoryID
+}
+
+func (b *CustomBudget) BigCategoryName() string {
+	return b.bigCategoryName
+}
+
+func (b *CustomBudget) Budget() vo.BudgetAmount {
+	return b.budget
+}
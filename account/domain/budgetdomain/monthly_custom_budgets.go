@@ -0,0 +1,23 @@
+package budgetdomain
+
+import "github.com/paypay3/tukecholl-api/account/domain/vo"
+
+type MonthlyCustomBudgets struct {
+	yearMonth     vo.YearMonth
+	customBudgets []*CustomBudget
+}
+
+func NewMonthlyCustomBudgets(yearMonth vo.YearMonth, customBudgets []*CustomBudget) *MonthlyCustomBudgets {
+	return &MonthlyCustomBudgets{
+		yearMonth:     yearMonth,
+		customBudgets: customBudgets,
+	}
+}
+
+func (b *MonthlyCustomBudgets) YearMonth() vo.YearMonth {
+	return b.yearMonth
+}
+
+func (b *MonthlyCustomBudgets) CustomBudgets() []*CustomBudget {
+	return b.customBudgets
+}
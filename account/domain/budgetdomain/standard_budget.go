@@ -0,0 +1,29 @@
+package budgetdomain
+
+import "github.com/paypay3/tukecholl-api/account/domain/vo"
+
+type StandardBudget struct {
+	bigCategoryID   vo.BigCategoryID
+	bigCategoryName string
+	budget          vo.BudgetAmount
+}
+
+func NewStandardBudget(bigCategoryID vo.BigCategoryID, bigCategoryName string, budget vo.BudgetAmount) *StandardBudget {
+	return &StandardBudget{
+		bigCategoryID:   bigCategoryID,
+		bigCategoryName: bigCategoryName,
+		budget:          budget,
+	}
+}
+
+func (b *StandardBudget) BigCategoryID() vo.BigCategoryID {
+	return b.bigCategoryID
+}
+
+func (b *StandardBudget) BigCategoryName() string {
+	return b.bigCategoryName
+}
+
+func (b *StandardBudget) Budget() vo.BudgetAmount {
+	return b.budget
+}
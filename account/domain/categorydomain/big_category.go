@@ -0,0 +1,34 @@
+package categorydomain
+
+import "github.com/paypay3/tukecholl-api/account/domain/vo"
+
+type BigCategory struct {
+	id              vo.BigCategoryID
+	name            string
+	transactionType vo.TransactionType
+}
+
+func NewBigCategory(id vo.BigCategoryID, name string, transactionType vo.TransactionType) *BigCategory {
+	return &BigCategory{
+		id:              id,
+		name:            name,
+		transactionType: transactionType,
+	}
+}
+
+func (c *BigCategory) ID() vo.BigCategoryID {
+	return c.id
+}
+
+func (c *BigCategory) Name() string {
+	return c.name
+}
+
+func (c *BigCategory) TransactionType() vo.TransactionType {
+	return c.transactionType
+}
+
+// IsBudgetable reports whether budgets can be set for the category, which is the case only for expense categories.
+func (c *BigCategory) IsBudgetable() bool {
+	return c.transactionType == vo.TransactionTypeExpense
+}
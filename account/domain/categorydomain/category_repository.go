@@ -0,0 +1,17 @@
+package categorydomain
+
+import (
+	"context"
+
+	"github.com/paypay3/tukecholl-api/account/domain/vo"
+)
+
+type Repository interface {
+	GetBigCategories(ctx context.Context) ([]*BigCategory, error)
+	GetMediumCategories(ctx context.Context) ([]*MediumCategory, error)
+	GetCustomCategories(ctx context.Context, userID vo.UserID) ([]*CustomCategory, error)
+	GetCustomCategory(ctx context.Context, userID vo.UserID, customCategoryID vo.CustomCategoryID) (*CustomCategory, error)
+	CreateCustomCategory(ctx context.Context, customCategory *CustomCategory) (vo.CustomCategoryID, error)
+	EditCustomCategory(ctx context.Context, customCategory *CustomCategory) error
+	DeleteCustomCategory(ctx context.Context, userID vo.UserID, customCategoryID vo.CustomCategoryID) error
+}
@@ -0,0 +1,40 @@
+package categorydomain
+
+import "github.com/paypay3/tukecholl-api/account/domain/vo"
+
+// CustomCategory is a medium category defined by a user.
+type CustomCategory struct {
+	id            vo.CustomCategoryID
+	name          vo.CategoryName
+	bigCategoryID vo.BigCategoryID
+	userID        vo.UserID
+}
+
+func NewCustomCategory(id vo.CustomCategoryID, name vo.CategoryName, bigCategoryID vo.BigCategoryID, userID vo.UserID) *CustomCategory {
+	return &CustomCategory{
+		id:            id,
+		name:          name,
+		bigCategoryID: bigCategoryID,
+		userID:        userID,
+	}
+}
+
+func (c *CustomCategory) ID() vo.CustomCategoryID {
+	return c.id
+}
+
+func (c *CustomCategory) Name() vo.CategoryName {
+	return c.name
+}
+
+func (c *CustomCategory) BigCategoryID() vo.BigCategoryID {
+	return c.bigCategoryID
+}
+
+func (c *CustomCategory) UserID() vo.UserID {
+	return c.userID
+}
+
+func (c *CustomCategory) Rename(name vo.CategoryName) {
+	c.name = name
+}
@@ -0,0 +1,29 @@
+package categorydomain
+
+import "github.com/paypay3/tukecholl-api/account/domain/vo"
+
+type MediumCategory struct {
+	id            vo.MediumCategoryID
+	name          string
+	bigCategoryID vo.BigCategoryID
+}
+
+func NewMediumCategory(id vo.MediumCategoryID, name string, bigCategoryID vo.BigCategoryID) *MediumCategory {
+	return &MediumCategory{
+		id:            id,
+		name:          name,
+		bigCategoryID: bigCategoryID,
+	}
+}
+
+func (c *MediumCategory) ID() vo.MediumCategoryID {
+	return c.id
+}
+
+func (c *MediumCategory) Name() string {
+	return c.name
+}
+
+func (c *MediumCategory) BigCategoryID() vo.BigCategoryID {
+	return c.bigCategoryID
+}
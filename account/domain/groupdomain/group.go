@@ -0,0 +1,23 @@
+package groupdomain
+
+import "github.com/paypay3/tukecholl-api/account/domain/vo"
+
+type Group struct {
+	id   int
+	name vo.GroupName
+}
+
+func NewGroup(id int, name vo.GroupName) *Group {
+	return &Group{
+		id:   id,
+		name: name,
+	}
+}
+
+func (g *Group) ID() int {
+	return g.id
+}
+
+func (g *Group) Name() vo.GroupName {
+	return g.name
+}
@@ -0,0 +1,25 @@
+package groupdomain
+
+import (
+	"context"
+
+	"github.com/paypay3/tukecholl-api/account/domain/vo"
+)
+
+// Repository manages groups and their members.
+// approved users are the members of a group, and unapproved users are the ones invited to it.
+type Repository interface {
+	CreateGroup(ctx context.Context, name vo.GroupName) (int, error)
+	GetGroup(ctx context.Context, groupID int) (*Group, error)
+	// LockGroup locks the group until the end of the transaction, so that the changes of its members are serialized.
+	LockGroup(ctx context.Context, groupID int) error
+	GetApprovedGroups(ctx context.Context, userID vo.UserID) ([]*Group, error)
+	GetUnapprovedGroups(ctx context.Context, userID vo.UserID) ([]*Group, error)
+	CreateApprovedUser(ctx context.Context, groupID int, userID vo.UserID) error
+	DeleteApprovedUser(ctx context.Context, groupID int, userID vo.UserID) error
+	CreateUnapprovedUser(ctx context.Context, groupID int, userID vo.UserID) error
+	DeleteUnapprovedUser(ctx context.Context, groupID int, userID vo.UserID) error
+	IsApprovedUser(ctx context.Context, groupID int, userID vo.UserID) (bool, error)
+	GetApprovedMembers(ctx context.Context, groupID int) ([]*Member, error)
+	GetUnapprovedMembers(ctx context.Context, groupID int) ([]*Member, error)
+}
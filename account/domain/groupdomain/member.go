@@ -0,0 +1,24 @@
+package groupdomain
+
+import "github.com/paypay3/tukecholl-api/account/domain/vo"
+
+// Member is a user who belongs to a group or who is invited to it.
+type Member struct {
+	userID   vo.UserID
+	userName vo.UserName
+}
+
+func NewMember(userID vo.UserID, userName vo.UserName) *Member {
+	return &Member{
+		userID:   userID,
+		userName: userName,
+	}
+}
+
+func (m *Member) UserID() vo.UserID {
+	return m.userID
+}
+
+func (m *Member) UserName() vo.UserName {
+	return m.userName
+}
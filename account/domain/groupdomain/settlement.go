@@ -0,0 +1,176 @@
+package groupdomain
+
+import (
+	"sort"
+
+	"github.com/paypay3/tukecholl-api/account/domain/vo"
+)
+
+// MemberAccount is how much a member paid for the group in a month, compared with the member's fair share.
+type MemberAccount struct {
+	userID     vo.UserID
+	paidAmount int
+	fairShare  int
+}
+
+func (a *MemberAccount) UserID() vo.UserID {
+	return a.userID
+}
+
+func (a *MemberAccount) PaidAmount() int {
+	return a.paidAmount
+}
+
+func (a *MemberAccount) FairShare() int {
+	return a.fairShare
+}
+
+// Balance is positive if the member paid more than the fair share, and negative if less.
+func (a *MemberAccount) Balance() int {
+	return a.paidAmount - a.fairShare
+}
+
+// Transfer is a payment from a member who paid less than the fair share to a member who paid more.
+type Transfer struct {
+	payerUserID     vo.UserID
+	recipientUserID vo.UserID
+	amount          int
+}
+
+func (t *Transfer) PayerUserID() vo.UserID {
+	return t.payerUserID
+}
+
+func (t *Transfer) RecipientUserID() vo.UserID {
+	return t.recipientUserID
+}
+
+func (t *Transfer) Amount() int {
+	return t.amount
+}
+
+type Settlement struct {
+	totalAmount    int
+	memberAccounts []*MemberAccount
+	transfers      []*Transfer
+}
+
+func (s *Settlement) TotalAmount() int {
+	return s.totalAmount
+}
+
+func (s *Settlement) MemberAccounts() []*MemberAccount {
+	return s.memberAccounts
+}
+
+func (s *Settlement) Transfers() []*Transfer {
+	return s.transfers
+}
+
+// CalculateSettlement splits the total amount paid by the members equally, and computes the transfers to settle it.
+// paidAmounts maps each member to the amount the member paid, and every member must be in it even if the amount is 0.
+//
+// yen has no fraction, so the remainder of the split is borne by one yen each by the members in ascending order of user id.
+// the transfers are made greedily from the member who owes the most to the member who is owed the most,
+// which settles the accounts with at most one transfer less than the number of members.
+func CalculateSettlement(paidAmounts map[vo.UserID]int) *Settlement {
+	userIDs := make([]vo.UserID, 0, len(paidAmounts))
+	totalAmount := 0
+	for userID, paidAmount := range paidAmounts {
+		userIDs = append(userIDs, userID)
+		totalAmount += paidAmount
+	}
+
+	sort.Slice(userIDs, func(i, j int) bool {
+		return userIDs[i] < userIDs[j]
+	})
+
+	settlement := &Settlement{
+		totalAmount:    totalAmount,
+		memberAccounts: make([]*MemberAccount, len(userIDs)),
+		transfers:      make([]*Transfer, 0),
+	}
+
+	if len(userIDs) == 0 {
+		return settlement
+	}
+
+	share := totalAmount / len(userIDs)
+	remainder := totalAmount % len(userIDs)
+	for i, userID := range userIDs {
+		fairShare := share
+		if i < remainder {
+			fairShare++
+		}
+
+		settlement.memberAccounts[i] = &MemberAccount{
+			userID:     userID,
+			paidAmount: paidAmounts[userID],
+			fairShare:  fairShare,
+		}
+	}
+
+	settlement.transfers = calculateTransfers(settlement.memberAccounts)
+
+	return settlement
+}
+
+type memberBalance struct {
+	userID  vo.UserID
+	balance int
+}
+
+func calculateTransfers(memberAccounts []*MemberAccount) []*Transfer {
+	var payers, recipients []*memberBalance
+	for _, memberAccount := range memberAccounts {
+		switch balance := memberAccount.Balance(); {
+		case balance < 0:
+			payers = append(payers, &memberBalance{userID: memberAccount.UserID(), balance: -balance})
+		case balance > 0:
+			recipients = append(recipients, &memberBalance{userID: memberAccount.UserID(), balance: balance})
+		}
+	}
+
+	transfers := make([]*Transfer, 0)
+	for len(payers) > 0 && len(recipients) > 0 {
+		sortMemberBalances(payers)
+		sortMemberBalances(recipients)
+
+		payer, recipient := payers[0], recipients[0]
+		amount := payer.balance
+		if recipient.balance < amount {
+			amount = recipient.balance
+		}
+
+		transfers = append(transfers, &Transfer{
+			payerUserID:     payer.userID,
+			recipientUserID: recipient.userID,
+			amount:          amount,
+		})
+
+		payer.balance -= amount
+		recipient.balance -= amount
+
+		if payer.balance == 0 {
+			payers = payers[1:]
+		}
+
+		if recipient.balance == 0 {
+			recipients = recipients[1:]
+		}
+	}
+
+	return transfers
+}
+
+// sortMemberBalances sorts in descending order of balance, and in ascending order of user id for the same balance
+// so that the result is deterministic.
+func sortMemberBalances(memberBalances []*memberBalance) {
+	sort.Slice(memberBalances, func(i, j int) bool {
+		if memberBalances[i].balance != memberBalances[j].balance {
+			return memberBalances[i].balance > memberBalances[j].balance
+		}
+
+		return memberBalances[i].userID < memberBalances[j].userID
+	})
+}
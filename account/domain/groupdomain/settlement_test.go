@@ -0,0 +1,171 @@
+package groupdomain
+
+import (
+	"testing"
+
+	"github.com/paypay3/tukecholl-api/account/domain/vo"
+)
+
+type wantMemberAccount struct {
+	userID     vo.UserID
+	paidAmount int
+	fairShare  int
+}
+
+type wantTransfer struct {
+	payerUserID     vo.UserID
+	recipientUserID vo.UserID
+	amount          int
+}
+
+func TestCalculateSettlement(t *testing.T) {
+	tests := []struct {
+		name               string
+		paidAmounts        map[vo.UserID]int
+		wantTotalAmount    int
+		wantMemberAccounts []wantMemberAccount
+		wantTransfers      []wantTransfer
+	}{
+		{
+			name:               "no members",
+			paidAmounts:        map[vo.UserID]int{},
+			wantTotalAmount:    0,
+			wantMemberAccounts: []wantMemberAccount{},
+			wantTransfers:      []wantTransfer{},
+		},
+		{
+			name:            "single member",
+			paidAmounts:     map[vo.UserID]int{"alice": 1234},
+			wantTotalAmount: 1234,
+			wantMemberAccounts: []wantMemberAccount{
+				{userID: "alice", paidAmount: 1234, fairShare: 1234},
+			},
+			wantTransfers: []wantTransfer{},
+		},
+		{
+			name:            "divisible amount",
+			paidAmounts:     map[vo.UserID]int{"alice": 3000, "bob": 1000},
+			wantTotalAmount: 4000,
+			wantMemberAccounts: []wantMemberAccount{
+				{userID: "alice", paidAmount: 3000, fairShare: 2000},
+				{userID: "bob", paidAmount: 1000, fairShare: 2000},
+			},
+			wantTransfers: []wantTransfer{
+				{payerUserID: "bob", recipientUserID: "alice", amount: 1000},
+			},
+		},
+		{
+			name:            "one yen remainder is borne by the first member in user id order",
+			paidAmounts:     map[vo.UserID]int{"bob": 1001, "alice": 0},
+			wantTotalAmount: 1001,
+			wantMemberAccounts: []wantMemberAccount{
+				{userID: "alice", paidAmount: 0, fairShare: 501},
+				{userID: "bob", paidAmount: 1001, fairShare: 500},
+			},
+			wantTransfers: []wantTransfer{
+				{payerUserID: "alice", recipientUserID: "bob", amount: 501},
+			},
+		},
+		{
+			name:            "two yen remainder among three members",
+			paidAmounts:     map[vo.UserID]int{"alice": 0, "bob": 0, "carol": 1000},
+			wantTotalAmount: 1000,
+			wantMemberAccounts: []wantMemberAccount{
+				{userID: "alice", paidAmount: 0, fairShare: 334},
+				{userID: "bob", paidAmount: 0, fairShare: 333},
+				{userID: "carol", paidAmount: 1000, fairShare: 333},
+			},
+			wantTransfers: []wantTransfer{
+				{payerUserID: "alice", recipientUserID: "carol", amount: 334},
+				{payerUserID: "bob", recipientUserID: "carol", amount: 333},
+			},
+		},
+		{
+			name:            "amount smaller than the number of members",
+			paidAmounts:     map[vo.UserID]int{"alice": 0, "bob": 2, "carol": 0},
+			wantTotalAmount: 2,
+			wantMemberAccounts: []wantMemberAccount{
+				{userID: "alice", paidAmount: 0, fairShare: 1},
+				{userID: "bob", paidAmount: 2, fairShare: 1},
+				{userID: "carol", paidAmount: 0, fairShare: 0},
+			},
+			wantTransfers: []wantTransfer{
+				{payerUserID: "alice", recipientUserID: "bob", amount: 1},
+			},
+		},
+		{
+			name:            "already settled",
+			paidAmounts:     map[vo.UserID]int{"alice": 500, "bob": 500},
+			wantTotalAmount: 1000,
+			wantMemberAccounts: []wantMemberAccount{
+				{userID: "alice", paidAmount: 500, fairShare: 500},
+				{userID: "bob", paidAmount: 500, fairShare: 500},
+			},
+			wantTransfers: []wantTransfer{},
+		},
+		{
+			name:            "several payers and recipients",
+			paidAmounts:     map[vo.UserID]int{"alice": 10000, "bob": 7000, "carol": 1000, "dave": 0},
+			wantTotalAmount: 18000,
+			wantMemberAccounts: []wantMemberAccount{
+				{userID: "alice", paidAmount: 10000, fairShare: 4500},
+				{userID: "bob", paidAmount: 7000, fairShare: 4500},
+				{userID: "carol", paidAmount: 1000, fairShare: 4500},
+				{userID: "dave", paidAmount: 0, fairShare: 4500},
+			},
+			wantTransfers: []wantTransfer{
+				{payerUserID: "dave", recipientUserID: "alice", amount: 4500},
+				{payerUserID: "carol", recipientUserID: "bob", amount: 2500},
+				{payerUserID: "carol", recipientUserID: "alice", amount: 1000},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settlement := CalculateSettlement(tt.paidAmounts)
+
+			if got := settlement.TotalAmount(); got != tt.wantTotalAmount {
+				t.Errorf("TotalAmount() = %d, want %d", got, tt.wantTotalAmount)
+			}
+
+			memberAccounts := settlement.MemberAccounts()
+			if len(memberAccounts) != len(tt.wantMemberAccounts) {
+				t.Fatalf("len(MemberAccounts()) = %d, want %d", len(memberAccounts), len(tt.wantMemberAccounts))
+			}
+
+			fairShareSum, balanceSum := 0, 0
+			for i, want := range tt.wantMemberAccounts {
+				got := memberAccounts[i]
+				if got.UserID() != want.userID || got.PaidAmount() != want.paidAmount || got.FairShare() != want.fairShare {
+					t.Errorf("MemberAccounts()[%d] = {%s %d %d}, want {%s %d %d}",
+						i, got.UserID(), got.PaidAmount(), got.FairShare(), want.userID, want.paidAmount, want.fairShare)
+				}
+
+				fairShareSum += got.FairShare()
+				balanceSum += got.Balance()
+			}
+
+			if fairShareSum != tt.wantTotalAmount {
+				t.Errorf("sum of fair shares = %d, want %d", fairShareSum, tt.wantTotalAmount)
+			}
+
+			if balanceSum != 0 {
+				t.Errorf("sum of balances = %d, want 0", balanceSum)
+			}
+
+			transfers := settlement.Transfers()
+			if len(transfers) != len(tt.wantTransfers) {
+				t.Fatalf("len(Transfers()) = %d, want %d", len(transfers), len(tt.wantTransfers))
+			}
+
+			for i, want := range tt.wantTransfers {
+				got := transfers[i]
+				if got.PayerUserID() != want.payerUserID || got.RecipientUserID() != want.recipientUserID || got.Amount() != want.amount {
+					t.Errorf("Transfers()[%d] = {%s %s %d}, want {%s %s %d}",
+						i, got.PayerUserID(), got.RecipientUserID(), got.Amount(), want.payerUserID, want.recipientUserID, want.amount)
+				}
+			}
+		})
+	}
+}
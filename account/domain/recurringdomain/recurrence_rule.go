@@ -0,0 +1,82 @@
+package recurringdomain
+
+import (
+	"time"
+
+	"golang.org/x/xerrors"
+)
+
+type RecurrenceType int
+
+const (
+	RecurrenceTypeMonthly RecurrenceType = 1
+	RecurrenceTypeWeekly  RecurrenceType = 2
+)
+
+const (
+	minDayOfMonth = 1
+	maxDayOfMonth = 31
+)
+
+// RecurrenceRule decides on which dates a recurring transaction occurs,
+// either on a day of every month or on a day of every week.
+type RecurrenceRule struct {
+	recurrenceType RecurrenceType
+	dayOfMonth     int
+	dayOfWeek      time.Weekday
+}
+
+// NewMonthlyRecurrenceRule occurs on the last day of the month in months shorter than dayOfMonth,
+// so that 31 means the end of every month.
+func NewMonthlyRecurrenceRule(dayOfMonth int) (*RecurrenceRule, error) {
+	if dayOfMonth < minDayOfMonth || dayOfMonth > maxDayOfMonth {
+		return nil, xerrors.Errorf("day of month must be %d or more and %d or less: %d", minDayOfMonth, maxDayOfMonth, dayOfMonth)
+	}
+
+	return &RecurrenceRule{
+		recurrenceType: RecurrenceTypeMonthly,
+		dayOfMonth:     dayOfMonth,
+	}, nil
+}
+
+// NewWeeklyRecurrenceRule takes the day of week as time.Weekday does, 0 for Sunday to 6 for Saturday.
+func NewWeeklyRecurrenceRule(dayOfWeek int) (*RecurrenceRule, error) {
+	if dayOfWeek < int(time.Sunday) || dayOfWeek > int(time.Saturday) {
+		return nil, xerrors.Errorf("day of week must be %d or more and %d or less: %d", time.Sunday, time.Saturday, dayOfWeek)
+	}
+
+	return &RecurrenceRule{
+		recurrenceType: RecurrenceTypeWeekly,
+		dayOfWeek:      time.Weekday(dayOfWeek),
+	}, nil
+}
+
+func (r *RecurrenceRule) RecurrenceType() RecurrenceType {
+	return r.recurrenceType
+}
+
+// DayOfMonth is 0 for weekly rules.
+func (r *RecurrenceRule) DayOfMonth() int {
+	return r.dayOfMonth
+}
+
+// DayOfWeek is meaningful only for weekly rules.
+func (r *RecurrenceRule) DayOfWeek() time.Weekday {
+	return r.dayOfWeek
+}
+
+func (r *RecurrenceRule) Matches(date time.Time) bool {
+	switch r.recurrenceType {
+	case RecurrenceTypeMonthly:
+		day := r.dayOfMonth
+		if lastDay := time.Date(date.Year(), date.Month()+1, 0, 0, 0, 0, 0, date.Location()).Day(); day > lastDay {
+			day = lastDay
+		}
+
+		return date.Day() == day
+	case RecurrenceTypeWeekly:
+		return date.Weekday() == r.dayOfWeek
+	default:
+		return false
+	}
+}
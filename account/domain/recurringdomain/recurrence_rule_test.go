@@ -0,0 +1,90 @@
+package recurringdomain
+
+import (
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestNewRecurrenceRule(t *testing.T) {
+	tests := []struct {
+		name    string
+		newRule func() (*RecurrenceRule, error)
+		wantErr bool
+	}{
+		{name: "monthly on the first day", newRule: func() (*RecurrenceRule, error) { return NewMonthlyRecurrenceRule(1) }, wantErr: false},
+		{name: "monthly on the 31st", newRule: func() (*RecurrenceRule, error) { return NewMonthlyRecurrenceRule(31) }, wantErr: false},
+		{name: "monthly on day 0", newRule: func() (*RecurrenceRule, error) { return NewMonthlyRecurrenceRule(0) }, wantErr: true},
+		{name: "monthly on day 32", newRule: func() (*RecurrenceRule, error) { return NewMonthlyRecurrenceRule(32) }, wantErr: true},
+		{name: "weekly on Sunday", newRule: func() (*RecurrenceRule, error) { return NewWeeklyRecurrenceRule(0) }, wantErr: false},
+		{name: "weekly on Saturday", newRule: func() (*RecurrenceRule, error) { return NewWeeklyRecurrenceRule(6) }, wantErr: false},
+		{name: "weekly on day -1", newRule: func() (*RecurrenceRule, error) { return NewWeeklyRecurrenceRule(-1) }, wantErr: true},
+		{name: "weekly on day 7", newRule: func() (*RecurrenceRule, error) { return NewWeeklyRecurrenceRule(7) }, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := tt.newRule()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %t", err, tt.wantErr)
+			}
+
+			if err == nil && rule == nil {
+				t.Errorf("rule = nil, want a rule")
+			}
+		})
+	}
+}
+
+func TestRecurrenceRuleMatches(t *testing.T) {
+	monthly := func(dayOfMonth int) *RecurrenceRule {
+		rule, err := NewMonthlyRecurrenceRule(dayOfMonth)
+		if err != nil {
+			t.Fatalf("NewMonthlyRecurrenceRule(%d) error = %v", dayOfMonth, err)
+		}
+
+		return rule
+	}
+
+	weekly := func(dayOfWeek time.Weekday) *RecurrenceRule {
+		rule, err := NewWeeklyRecurrenceRule(int(dayOfWeek))
+		if err != nil {
+			t.Fatalf("NewWeeklyRecurrenceRule(%d) error = %v", dayOfWeek, err)
+		}
+
+		return rule
+	}
+
+	tests := []struct {
+		name string
+		rule *RecurrenceRule
+		date time.Time
+		want bool
+	}{
+		{name: "monthly on the day", rule: monthly(15), date: date(2021, time.March, 15), want: true},
+		{name: "monthly on another day", rule: monthly(15), date: date(2021, time.March, 14), want: false},
+		{name: "31st on the 31st", rule: monthly(31), date: date(2021, time.January, 31), want: true},
+		{name: "31st is not the 30th of a long month", rule: monthly(31), date: date(2021, time.January, 30), want: false},
+		{name: "31st on the last day of April", rule: monthly(31), date: date(2021, time.April, 30), want: true},
+		{name: "31st on the last day of February", rule: monthly(31), date: date(2021, time.February, 28), want: true},
+		{name: "31st on the last day of February in a leap year", rule: monthly(31), date: date(2020, time.February, 29), want: true},
+		{name: "31st is not the 28th of February in a leap year", rule: monthly(31), date: date(2020, time.February, 28), want: false},
+		{name: "29th on the last day of February", rule: monthly(29), date: date(2021, time.February, 28), want: true},
+		{name: "29th on the 29th of February in a leap year", rule: monthly(29), date: date(2020, time.February, 29), want: true},
+		{name: "30th is not the 31st", rule: monthly(30), date: date(2021, time.March, 31), want: false},
+		{name: "weekly on Sunday", rule: weekly(time.Sunday), date: date(2021, time.May, 2), want: true},
+		{name: "weekly on another day", rule: weekly(time.Sunday), date: date(2021, time.May, 3), want: false},
+		{name: "weekly on Saturday", rule: weekly(time.Saturday), date: date(2021, time.May, 8), want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rule.Matches(tt.date); got != tt.want {
+				t.Errorf("Matches(%s) = %t, want %t", tt.date.Format("2006-01-02"), got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package recurringdomain
+
+import (
+	"time"
+
+	"github.com/paypay3/tukecholl-api/account/domain/transactiondomain"
+	"github.com/paypay3/tukecholl-api/account/domain/vo"
+)
+
+// RecurringTransaction is the definition of a transaction of a user which repeats, such as rent or salary.
+// mediumCategoryID and customCategoryID are 0 if not set, and at most one of them is set.
+// endDate is zero if it repeats forever, and lastExecutionDate is zero if it has never been executed.
+type RecurringTransaction struct {
+	id                int
+	transactionType   vo.TransactionType
+	shop              vo.Shop
+	memo              vo.Memo
+	amount            vo.Amount
+	userID            vo.UserID
+	bigCategoryID     vo.BigCategoryID
+	mediumCategoryID  vo.MediumCategoryID
+	customCategoryID  vo.CustomCategoryID
+	recurrenceRule    *RecurrenceRule
+	startDate         time.Time
+	endDate           time.Time
+	lastExecutionDate time.Time
+}
+
+func NewRecurringTransaction(
+	id int,
+	transactionType vo.TransactionType,
+	shop vo.Shop,
+	memo vo.Memo,
+	amount vo.Amount,
+	userID vo.UserID,
+	bigCategoryID vo.BigCategoryID,
+	mediumCategoryID vo.MediumCategoryID,
+	customCategoryID vo.CustomCategoryID,
+	recurrenceRule *RecurrenceRule,
+	startDate time.Time,
+	endDate time.Time,
+	lastExecutionDate time.Time,
+) *RecurringTransaction {
+	return &RecurringTransaction{
+		id:                id,
+		transactionType:   transactionType,
+		shop:              shop,
+		memo:              memo,
+		amount:            amount,
+		userID:            userID,
+		bigCategoryID:     bigCategoryID,
+		mediumCategoryID:  mediumCategoryID,
+		customCategoryID:  customCategoryID,
+		recurrenceRule:    recurrenceRule,
+		startDate:         startDate,
+		endDate:           endDate,
+		lastExecutionDate: lastExecutionDate,
+	}
+}
+
+func (t *RecurringTransaction) ID() int {
+	return t.id
+}
+
+func (t *RecurringTransaction) TransactionType() vo.TransactionType {
+	return t.transactionType
+}
+
+func (t *RecurringTransaction) Shop() vo.Shop {
+	return t.shop
+}
+
+func (t *RecurringTransaction) Memo() vo.Memo {
+	return t.memo
+}
+
+func (t *RecurringTransaction) Amount() vo.Amount {
+	return t.amount
+}
+
+func (t *RecurringTransaction) UserID() vo.UserID {
+	return t.userID
+}
+
+func (t *RecurringTransaction) BigCategoryID() vo.BigCategoryID {
+	return t.bigCategoryID
+}
+
+func (t *RecurringTransaction) MediumCategoryID() vo.MediumCategoryID {
+	return t.mediumCategoryID
+}
+
+func (t *RecurringTransaction) CustomCategoryID() vo.CustomCategoryID {
+	return t.customCategoryID
+}
+
+func (t *RecurringTransaction) RecurrenceRule() *RecurrenceRule {
+	return t.recurrenceRule
+}
+
+func (t *RecurringTransaction) StartDate() time.Time {
+	return t.startDate
+}
+
+func (t *RecurringTransaction) EndDate() time.Time {
+	return t.endDate
+}
+
+func (t *RecurringTransaction) LastExecutionDate() time.Time {
+	return t.lastExecutionDate
+}
+
+// DueDates returns the dates on which the transaction occurs up to the given date and which have not been executed yet,
+// in ascending order. at most limit dates are returned, so that a transaction started long ago is caught up
+// little by little over the runs instead of all at once.
+func (t *RecurringTransaction) DueDates(until time.Time, limit int) []time.Time {
+	from := t.startDate
+	if !t.lastExecutionDate.IsZero() && !t.lastExecutionDate.Before(from) {
+		from = t.lastExecutionDate.AddDate(0, 0, 1)
+	}
+
+	to := until
+	if !t.endDate.IsZero() && t.endDate.Before(to) {
+		to = t.endDate
+	}
+
+	dueDates := make([]time.Time, 0)
+	for date := from; !date.After(to) && len(dueDates) < limit; date = date.AddDate(0, 0, 1) {
+		if t.recurrenceRule.Matches(date) {
+			dueDates = append(dueDates, date)
+		}
+	}
+
+	return dueDates
+}
+
+// ToTransaction builds the transaction which the recurring transaction materializes on the date.
+// the posted and updated dates are left zero since they are set by the database.
+func (t *RecurringTransaction) ToTransaction(date time.Time) *transactiondomain.Transaction {
+	return transactiondomain.NewTransaction(
+		0,
+		t.transactionType,
+		time.Time{},
+		time.Time{},
+		date,
+		t.shop,
+		t.memo,
+		t.amount,
+		t.userID,
+		t.bigCategoryID,
+		t.mediumCategoryID,
+		t.customCategoryID,
+	)
+}
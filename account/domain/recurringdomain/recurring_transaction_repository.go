@@ -0,0 +1,22 @@
+package recurringdomain
+
+import (
+	"context"
+	"time"
+
+	"github.com/paypay3/tukecholl-api/account/domain/vo"
+)
+
+type Repository interface {
+	CreateRecurringTransaction(ctx context.Context, recurringTransaction *RecurringTransaction) (int, error)
+	GetRecurringTransaction(ctx context.Context, userID vo.UserID, recurringTransactionID int) (*RecurringTransaction, error)
+	GetRecurringTransactions(ctx context.Context, userID vo.UserID) ([]*RecurringTransaction, error)
+	// GetActiveRecurringTransactions gets the recurring transactions of all users which started by the date
+	// and may still have dates to be executed.
+	GetActiveRecurringTransactions(ctx context.Context, date time.Time) ([]*RecurringTransaction, error)
+	EditRecurringTransaction(ctx context.Context, recurringTransaction *RecurringTransaction) error
+	DeleteRecurringTransaction(ctx context.Context, userID vo.UserID, recurringTransactionID int) error
+	// CreateExecution records that the recurring transaction has been materialized on the date as the transaction.
+	// it returns AlreadyExists if the recurring transaction has already been executed on the date.
+	CreateExecution(ctx context.Context, recurringTransactionID int, executionDate time.Time, transactionID int) error
+}
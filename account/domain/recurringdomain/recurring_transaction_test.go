@@ -0,0 +1,145 @@
+package recurringdomain
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRecurringTransactionDueDates(t *testing.T) {
+	sunday, err := NewWeeklyRecurrenceRule(int(time.Sunday))
+	if err != nil {
+		t.Fatalf("NewWeeklyRecurrenceRule() error = %v", err)
+	}
+
+	endOfMonth, err := NewMonthlyRecurrenceRule(31)
+	if err != nil {
+		t.Fatalf("NewMonthlyRecurrenceRule() error = %v", err)
+	}
+
+	onThe29th, err := NewMonthlyRecurrenceRule(29)
+	if err != nil {
+		t.Fatalf("NewMonthlyRecurrenceRule() error = %v", err)
+	}
+
+	tests := []struct {
+		name              string
+		rule              *RecurrenceRule
+		startDate         time.Time
+		endDate           time.Time
+		lastExecutionDate time.Time
+		until             time.Time
+		limit             int
+		want              []time.Time
+	}{
+		{
+			name:      "end of month in short months",
+			rule:      endOfMonth,
+			startDate: date(2021, time.January, 1),
+			until:     date(2021, time.May, 15),
+			limit:     100,
+			want:      []time.Time{date(2021, time.January, 31), date(2021, time.February, 28), date(2021, time.March, 31), date(2021, time.April, 30)},
+		},
+		{
+			name:      "29th in a leap year",
+			rule:      onThe29th,
+			startDate: date(2020, time.January, 1),
+			until:     date(2020, time.March, 31),
+			limit:     100,
+			want:      []time.Time{date(2020, time.January, 29), date(2020, time.February, 29), date(2020, time.March, 29)},
+		},
+		{
+			name:      "start date and until are inclusive",
+			rule:      sunday,
+			startDate: date(2021, time.May, 2),
+			until:     date(2021, time.May, 16),
+			limit:     100,
+			want:      []time.Time{date(2021, time.May, 2), date(2021, time.May, 9), date(2021, time.May, 16)},
+		},
+		{
+			name:              "dates up to the last execution are skipped",
+			rule:              sunday,
+			startDate:         date(2021, time.May, 2),
+			lastExecutionDate: date(2021, time.May, 9),
+			until:             date(2021, time.May, 16),
+			limit:             100,
+			want:              []time.Time{date(2021, time.May, 16)},
+		},
+		{
+			name:              "last execution before the start date is ignored",
+			rule:              sunday,
+			startDate:         date(2021, time.May, 9),
+			lastExecutionDate: date(2021, time.May, 2),
+			until:             date(2021, time.May, 16),
+			limit:             100,
+			want:              []time.Time{date(2021, time.May, 9), date(2021, time.May, 16)},
+		},
+		{
+			name:      "end date is inclusive",
+			rule:      sunday,
+			startDate: date(2021, time.May, 2),
+			endDate:   date(2021, time.May, 9),
+			until:     date(2021, time.May, 16),
+			limit:     100,
+			want:      []time.Time{date(2021, time.May, 2), date(2021, time.May, 9)},
+		},
+		{
+			name:      "end date between due dates",
+			rule:      sunday,
+			startDate: date(2021, time.May, 2),
+			endDate:   date(2021, time.May, 12),
+			until:     date(2021, time.May, 31),
+			limit:     100,
+			want:      []time.Time{date(2021, time.May, 2), date(2021, time.May, 9)},
+		},
+		{
+			name:      "not started yet",
+			rule:      sunday,
+			startDate: date(2021, time.June, 6),
+			until:     date(2021, time.May, 31),
+			limit:     100,
+			want:      []time.Time{},
+		},
+		{
+			name:              "already executed up to until",
+			rule:              sunday,
+			startDate:         date(2021, time.May, 2),
+			lastExecutionDate: date(2021, time.May, 16),
+			until:             date(2021, time.May, 16),
+			limit:             100,
+			want:              []time.Time{},
+		},
+		{
+			name:      "at most limit dates from the oldest",
+			rule:      sunday,
+			startDate: date(2021, time.January, 3),
+			until:     date(2021, time.December, 31),
+			limit:     3,
+			want:      []time.Time{date(2021, time.January, 3), date(2021, time.January, 10), date(2021, time.January, 17)},
+		},
+		{
+			name:      "start date long ago is capped",
+			rule:      sunday,
+			startDate: date(1000, time.January, 1),
+			until:     date(2021, time.December, 31),
+			limit:     2,
+			want:      []time.Time{date(1000, time.January, 5), date(1000, time.January, 12)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recurringTransaction := NewRecurringTransaction(1, 0, "", "", 0, "", 0, 0, 0, tt.rule, tt.startDate, tt.endDate, tt.lastExecutionDate)
+
+			got := recurringTransaction.DueDates(tt.until, tt.limit)
+			if len(got) != len(tt.want) {
+				t.Fatalf("DueDates() = %v, want %v", got, tt.want)
+			}
+
+			for i := range tt.want {
+				if !got[i].Equal(tt.want[i]) {
+					t.Errorf("DueDates()[%d] = %s, want %s", i, got[i].Format("2006-01-02"), tt.want[i].Format("2006-01-02"))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package tododomain
+
+import (
+	"time"
+
+	"github.com/paypay3/tukecholl-api/account/domain/transactiondomain"
+	"github.com/paypay3/tukecholl-api/account/domain/vo"
+)
+
+// ShoppingItem is an item which a group plans to buy.
+// amount, mediumCategoryID and transactionID are 0 if not set, and assigneeUserID is empty if nobody is assigned.
+// transactionID is the group transaction which the item has been converted into when purchased.
+type ShoppingItem struct {
+	id                   int
+	postedDate           time.Time
+	updatedDate          time.Time
+	expectedPurchaseDate time.Time
+	name                 vo.ShoppingItemName
+	shop                 vo.Shop
+	amount               vo.Amount
+	completed            bool
+	groupID              int
+	postedUserID         vo.UserID
+	assigneeUserID       vo.UserID
+	bigCategoryID        vo.BigCategoryID
+	mediumCategoryID     vo.MediumCategoryID
+	transactionID        int
+}
+
+func NewShoppingItem(
+	id int,
+	postedDate time.Time,
+	updatedDate time.Time,
+	expectedPurchaseDate time.Time,
+	name vo.ShoppingItemName,
+	shop vo.Shop,
+	amount vo.Amount,
+	completed bool,
+	groupID int,
+	postedUserID vo.UserID,
+	assigneeUserID vo.UserID,
+	bigCategoryID vo.BigCategoryID,
+	mediumCategoryID vo.MediumCategoryID,
+	transactionID int,
+) *ShoppingItem {
+	return &ShoppingItem{
+		id:                   id,
+		postedDate:           postedDate,
+		updatedDate:          updatedDate,
+		expectedPurchaseDate: expectedPurchaseDate,
+		name:                 name,
+		shop:                 shop,
+		amount:               amount,
+		completed:            completed,
+		groupID:              groupID,
+		postedUserID:         postedUserID,
+		assigneeUserID:       assigneeUserID,
+		bigCategoryID:        bigCategoryID,
+		mediumCategoryID:     mediumCategoryID,
+		transactionID:        transactionID,
+	}
+}
+
+func (i *ShoppingItem) ID() int {
+	return i.id
+}
+
+func (i *ShoppingItem) PostedDate() time.Time {
+	return i.postedDate
+}
+
+func (i *ShoppingItem) UpdatedDate() time.Time {
+	return i.updatedDate
+}
+
+func (i *ShoppingItem) ExpectedPurchaseDate() time.Time {
+	return i.expectedPurchaseDate
+}
+
+func (i *ShoppingItem) Name() vo.ShoppingItemName {
+	return i.name
+}
+
+func (i *ShoppingItem) Shop() vo.Shop {
+	return i.shop
+}
+
+func (i *ShoppingItem) Amount() vo.Amount {
+	return i.amount
+}
+
+func (i *ShoppingItem) Completed() bool {
+	return i.completed
+}
+
+func (i *ShoppingItem) GroupID() int {
+	return i.groupID
+}
+
+func (i *ShoppingItem) PostedUserID() vo.UserID {
+	return i.postedUserID
+}
+
+func (i *ShoppingItem) AssigneeUserID() vo.UserID {
+	return i.assigneeUserID
+}
+
+func (i *ShoppingItem) BigCategoryID() vo.BigCategoryID {
+	return i.bigCategoryID
+}
+
+func (i *ShoppingItem) MediumCategoryID() vo.MediumCategoryID {
+	return i.mediumCategoryID
+}
+
+func (i *ShoppingItem) TransactionID() int {
+	return i.transactionID
+}
+
+func (i *ShoppingItem) IsPurchased() bool {
+	return i.transactionID != 0
+}
+
+func (i *ShoppingItem) SetCompleted(completed bool) {
+	i.completed = completed
+}
+
+// ToGroupTransaction builds the expense which the item is converted into when purchased, with the name as the memo.
+// the posted and updated dates are left zero since they are set by the database.
+func (i *ShoppingItem) ToGroupTransaction(transactionDate time.Time, amount vo.Amount, paymentUserID vo.UserID) *transactiondomain.GroupTransaction {
+	return transactiondomain.NewGroupTransaction(
+		0,
+		vo.TransactionTypeExpense,
+		time.Time{},
+		time.Time{},
+		transactionDate,
+		i.shop,
+		vo.Memo(i.name),
+		amount,
+		i.groupID,
+		paymentUserID,
+		"",
+		paymentUserID,
+		i.bigCategoryID,
+		i.mediumCategoryID,
+	)
+}
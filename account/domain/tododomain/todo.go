@@ -0,0 +1,92 @@
+package tododomain
+
+import (
+	"time"
+
+	"github.com/paypay3/tukecholl-api/account/domain/vo"
+)
+
+// Todo is a household task of a group, which should be done between the implementation date and the due date.
+// assigneeUserID is empty if nobody is assigned.
+type Todo struct {
+	id                 int
+	postedDate         time.Time
+	updatedDate        time.Time
+	implementationDate time.Time
+	dueDate            time.Time
+	content            vo.TodoContent
+	completed          bool
+	groupID            int
+	postedUserID       vo.UserID
+	assigneeUserID     vo.UserID
+}
+
+func NewTodo(
+	id int,
+	postedDate time.Time,
+	updatedDate time.Time,
+	implementationDate time.Time,
+	dueDate time.Time,
+	content vo.TodoContent,
+	completed bool,
+	groupID int,
+	postedUserID vo.UserID,
+	assigneeUserID vo.UserID,
+) *Todo {
+	return &Todo{
+		id:                 id,
+		postedDate:         postedDate,
+		updatedDate:        updatedDate,
+		implementationDate: implementationDate,
+		dueDate:            dueDate,
+		content:            content,
+		completed:          completed,
+		groupID:            groupID,
+		postedUserID:       postedUserID,
+		assigneeUserID:     assigneeUserID,
+	}
+}
+
+func (t *Todo) ID() int {
+	return t.id
+}
+
+func (t *Todo) PostedDate() time.Time {
+	return t.postedDate
+}
+
+func (t *Todo) UpdatedDate() time.Time {
+	return t.updatedDate
+}
+
+func (t *Todo) ImplementationDate() time.Time {
+	return t.implementationDate
+}
+
+func (t *Todo) DueDate() time.Time {
+	return t.dueDate
+}
+
+func (t *Todo) Content() vo.TodoContent {
+	return t.content
+}
+
+func (t *Todo) Completed() bool {
+	return t.completed
+}
+
+func (t *Todo) GroupID() int {
+	return t.groupID
+}
+
+func (t *Todo) PostedUserID() vo.UserID {
+	return t.postedUserID
+}
+
+func (t *Todo) AssigneeUserID() vo.UserID {
+	return t.assigneeUserID
+}
+
+func (t *Todo) SetCompleted(completed bool) {
+	t.completed = completed
+}
@@ -0,0 +1,24 @@
+package tododomain
+
+import (
+	"context"
+	"time"
+)
+
+// Repository manages the todos and the shopping items of groups.
+// the lists are filtered by the due date of todos and the expected purchase date of shopping items, both ends inclusive.
+type Repository interface {
+	CreateTodo(ctx context.Context, todo *Todo) (int, error)
+	GetTodo(ctx context.Context, groupID int, todoID int) (*Todo, error)
+	GetTodos(ctx context.Context, groupID int, from, to time.Time) ([]*Todo, error)
+	EditTodo(ctx context.Context, todo *Todo) error
+	DeleteTodo(ctx context.Context, groupID int, todoID int) error
+	CreateShoppingItem(ctx context.Context, shoppingItem *ShoppingItem) (int, error)
+	GetShoppingItem(ctx context.Context, groupID int, shoppingItemID int) (*ShoppingItem, error)
+	GetShoppingItems(ctx context.Context, groupID int, from, to time.Time) ([]*ShoppingItem, error)
+	EditShoppingItem(ctx context.Context, shoppingItem *ShoppingItem) error
+	DeleteShoppingItem(ctx context.Context, groupID int, shoppingItemID int) error
+	// PurchaseShoppingItem completes the shopping item and links it to the transaction it has been converted into.
+	// it returns FailedPrecondition if the shopping item has already been purchased.
+	PurchaseShoppingItem(ctx context.Context, groupID int, shoppingItemID int, transactionID int) error
+}
@@ -0,0 +1,24 @@
+package transactiondomain
+
+import "github.com/paypay3/tukecholl-api/account/domain/vo"
+
+// BigCategoryTotal is the total amount of the transactions of a big category.
+type BigCategoryTotal struct {
+	bigCategoryID vo.BigCategoryID
+	totalAmount   int
+}
+
+func NewBigCategoryTotal(bigCategoryID vo.BigCategoryID, totalAmount int) *BigCategoryTotal {
+	return &BigCategoryTotal{
+		bigCategoryID: bigCategoryID,
+		totalAmount:   totalAmount,
+	}
+}
+
+func (t *BigCategoryTotal) BigCategoryID() vo.BigCategoryID {
+	return t.bigCategoryID
+}
+
+func (t *BigCategoryTotal) TotalAmount() int {
+	return t.totalAmount
+}
@@ -0,0 +1,117 @@
+package transactiondomain
+
+import (
+	"time"
+
+	"github.com/paypay3/tukecholl-api/account/domain/vo"
+)
+
+// GroupTransaction is an income or expense entry shared by the members of a group.
+// paymentUserID is the member who actually paid or received the money, and updatedUserID is empty if never updated.
+// mediumCategoryID is 0 if not set.
+type GroupTransaction struct {
+	id               int
+	transactionType  vo.TransactionType
+	postedDate       time.Time
+	updatedDate      time.Time
+	transactionDate  time.Time
+	shop             vo.Shop
+	memo             vo.Memo
+	amount           vo.Amount
+	groupID          int
+	postedUserID     vo.UserID
+	updatedUserID    vo.UserID
+	paymentUserID    vo.UserID
+	bigCategoryID    vo.BigCategoryID
+	mediumCategoryID vo.MediumCategoryID
+}
+
+func NewGroupTransaction(
+	id int,
+	transactionType vo.TransactionType,
+	postedDate time.Time,
+	updatedDate time.Time,
+	transactionDate time.Time,
+	shop vo.Shop,
+	memo vo.Memo,
+	amount vo.Amount,
+	groupID int,
+	postedUserID vo.UserID,
+	updatedUserID vo.UserID,
+	paymentUserID vo.UserID,
+	bigCategoryID vo.BigCategoryID,
+	mediumCategoryID vo.MediumCategoryID,
+) *GroupTransaction {
+	return &GroupTransaction{
+		id:               id,
+		transactionType:  transactionType,
+		postedDate:       postedDate,
+		updatedDate:      updatedDate,
+		transactionDate:  transactionDate,
+		shop:             shop,
+		memo:             memo,
+		amount:           amount,
+		groupID:          groupID,
+		postedUserID:     postedUserID,
+		updatedUserID:    updatedUserID,
+		paymentUserID:    paymentUserID,
+		bigCategoryID:    bigCategoryID,
+		mediumCategoryID: mediumCategoryID,
+	}
+}
+
+func (t *GroupTransaction) ID() int {
+	return t.id
+}
+
+func (t *GroupTransaction) TransactionType() vo.TransactionType {
+	return t.transactionType
+}
+
+func (t *GroupTransaction) PostedDate() time.Time {
+	return t.postedDate
+}
+
+func (t *GroupTransaction) UpdatedDate() time.Time {
+	return t.updatedDate
+}
+
+func (t *GroupTransaction) TransactionDate() time.Time {
+	return t.transactionDate
+}
+
+func (t *GroupTransaction) Shop() vo.Shop {
+	return t.shop
+}
+
+func (t *GroupTransaction) Memo() vo.Memo {
+	return t.memo
+}
+
+func (t *GroupTransaction) Amount() vo.Amount {
+	return t.amount
+}
+
+func (t *GroupTransaction) GroupID() int {
+	return t.groupID
+}
+
+func (t *GroupTransaction) PostedUserID() vo.UserID {
+	return t.postedUserID
+}
+
+func (t *GroupTransaction) UpdatedUserID() vo.UserID {
+	return t.updatedUserID
+}
+
+func (t *GroupTransaction) PaymentUserID() vo.UserID {
+	return t.paymentUserID
+}
+
+func (t *GroupTransaction) BigCategoryID() vo.BigCategoryID {
+	return t.bigCategoryID
+}
+
+func (t *GroupTransaction) MediumCategoryID() vo.MediumCategoryID {
+	return t.mediumCategoryID
+}
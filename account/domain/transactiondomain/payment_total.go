@@ -0,0 +1,24 @@
+package transactiondomain
+
+import "github.com/paypay3/tukecholl-api/account/domain/vo"
+
+// PaymentTotal is the total amount of the group transactions paid by a user.
+type PaymentTotal struct {
+	paymentUserID vo.UserID
+	totalAmount   int
+}
+
+func NewPaymentTotal(paymentUserID vo.UserID, totalAmount int) *PaymentTotal {
+	return &PaymentTotal{
+		paymentUserID: paymentUserID,
+		totalAmount:   totalAmount,
+	}
+}
+
+func (t *PaymentTotal) PaymentUserID() vo.UserID {
+	return t.paymentUserID
+}
+
+func (t *PaymentTotal) TotalAmount() int {
+	return t.totalAmount
+}
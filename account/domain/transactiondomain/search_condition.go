@@ -0,0 +1,40 @@
+package transactiondomain
+
+import (
+	"time"
+
+	"github.com/paypay3/tukecholl-api/account/domain/vo"
+)
+
+type SortField int
+
+const (
+	SortFieldTransactionDate SortField = iota + 1
+	SortFieldAmount
+	SortFieldUpdatedDate
+)
+
+type SortOrder int
+
+const (
+	SortOrderAsc SortOrder = iota + 1
+	SortOrderDesc
+)
+
+// SearchCondition is the criteria to search the transactions of a user.
+// zero values of the optional criteria mean that they are not used to filter the transactions.
+type SearchCondition struct {
+	UserID           vo.UserID
+	TransactionType  vo.TransactionType
+	StartDate        time.Time
+	EndDate          time.Time
+	BigCategoryID    vo.BigCategoryID
+	MediumCategoryID vo.MediumCategoryID
+	CustomCategoryID vo.CustomCategoryID
+	LowAmount        vo.Amount
+	HighAmount       vo.Amount
+	Keyword          string
+	SortField        SortField
+	SortOrder        SortOrder
+	Limit            int
+}
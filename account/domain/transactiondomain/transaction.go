@@ -0,0 +1,102 @@
+package transactiondomain
+
+import (
+	"time"
+
+	"github.com/paypay3/tukecholl-api/account/domain/vo"
+)
+
+// Transaction is an income or expense entry of a user.
+// mediumCategoryID and customCategoryID are 0 if not set, and at most one of them is set.
+type Transaction struct {
+	id               int
+	transactionType  vo.TransactionType
+	postedDate       time.Time
+	updatedDate      time.Time
+	transactionDate  time.Time
+	shop             vo.Shop
+	memo             vo.Memo
+	amount           vo.Amount
+	userID           vo.UserID
+	bigCategoryID    vo.BigCategoryID
+	mediumCategoryID vo.MediumCategoryID
+	customCategoryID vo.CustomCategoryID
+}
+
+func NewTransaction(
+	id int,
+	transactionType vo.TransactionType,
+	postedDate time.Time,
+	updatedDate time.Time,
+	transactionDate time.Time,
+	shop vo.Shop,
+	memo vo.Memo,
+	amount vo.Amount,
+	userID vo.UserID,
+	bigCategoryID vo.BigCategoryID,
+	mediumCategoryID vo.MediumCategoryID,
+	customCategoryID vo.CustomCategoryID,
+) *Transaction {
+	return &Transaction{
+		id:               id,
+		transactionType:  transactionType,
+		postedDate:       postedDate,
+		updatedDate:      updatedDate,
+		transactionDate:  transactionDate,
+		shop:             shop,
+		memo:             memo,
+		amount:           amount,
+		userID:           userID,
+		bigCategoryID:    bigCategoryID,
+		mediumCategoryID: mediumCategoryID,
+		customCategoryID: customCategoryID,
+	}
+}
+
+func (t *Transaction) ID() int {
+	return t.id
+}
+
+func (t *Transaction) TransactionType() vo.TransactionType {
+	return t.transactionType
+}
+
+func (t *Transaction) PostedDate() time.Time {
+	return t.postedDate
+}
+
+func (t *Transaction) UpdatedDate() time.Time {
+	return t.updatedDate
+}
+
+func (t *Transaction) TransactionDate() time.Time {
+	return t.transactionDate
+}
+
+func (t *Transaction) Shop() vo.Shop {
+	return t.shop
+}
+
+func (t *Transaction) Memo() vo.Memo {
+	return t.memo
+}
+
+func (t *Transaction) Amount() vo.Amount {
+	return t.amount
+}
+
+func (t *Transaction) UserID() vo.UserID {
+	return t.userID
+}
+
+func (t *Transaction) BigCategoryID() vo.BigCategoryID {
+	return t.bigCategoryID
+}
+
+func (t *Transaction) MediumCategoryID() vo.MediumCategoryID {
+	return t.mediumCategoryID
+}
+
+func (t *Transaction) CustomCategoryID() vo.CustomCategoryID {
+	return t.customCategoryID
+}
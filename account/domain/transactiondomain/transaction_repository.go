@@ -0,0 +1,23 @@
+package transactiondomain
+
+import (
+	"context"
+
+	"github.com/paypay3/tukecholl-api/account/domain/vo"
+)
+
+type Repository interface {
+	CreateTransaction(ctx context.Context, transaction *Transaction) (int, error)
+	GetTransaction(ctx context.Context, userID vo.UserID, transactionID int) (*Transaction, error)
+	GetMonthlyTransactions(ctx context.Context, userID vo.UserID, yearMonth vo.YearMonth) ([]*Transaction, error)
+	SearchTransactions(ctx context.Context, condition *SearchCondition) ([]*Transaction, error)
+	GetMonthlyBigCategoryTotals(ctx context.Context, userID vo.UserID, yearMonth vo.YearMonth, transactionType vo.TransactionType) ([]*BigCategoryTotal, error)
+	EditTransaction(ctx context.Context, transaction *Transaction) error
+	DeleteTransaction(ctx context.Context, userID vo.UserID, transactionID int) error
+	CreateGroupTransaction(ctx context.Context, transaction *GroupTransaction) (int, error)
+	GetGroupTransaction(ctx context.Context, groupID int, transactionID int) (*GroupTransaction, error)
+	GetMonthlyGroupTransactions(ctx context.Context, groupID int, yearMonth vo.YearMonth) ([]*GroupTransaction, error)
+	GetMonthlyPaymentTotals(ctx context.Context, groupID int, yearMonth vo.YearMonth, transactionType vo.TransactionType) ([]*PaymentTotal, error)
+	EditGroupTransaction(ctx context.Context, transaction *GroupTransaction) error
+	DeleteGroupTransaction(ctx context.Context, groupID int, transactionID int) error
+}
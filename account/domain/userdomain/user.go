@@ -0,0 +1,35 @@
+package userdomain
+
+import "github.com/paypay3/tukecholl-api/account/domain/vo"
+
+type User struct {
+	id       vo.UserID
+	name     vo.UserName
+	email    vo.Email
+	password vo.Password
+}
+
+func NewUser(id vo.UserID, name vo.UserName, email vo.Email, password vo.Password) *User {
+	return &User{
+		id:       id,
+		name:     name,
+		email:    email,
+		password: password,
+	}
+}
+
+func (u *User) ID() vo.UserID {
+	return u.id
+}
+
+func (u *User) Name() vo.UserName {
+	return u.name
+}
+
+func (u *User) Email() vo.Email {
+	return u.email
+}
+
+func (u *User) Password() vo.Password {
+	return u.password
+}
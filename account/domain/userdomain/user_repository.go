@@ -0,0 +1,13 @@
+package userdomain
+
+import (
+	"context"
+
+	"github.com/paypay3/tukecholl-api/account/domain/vo"
+)
+
+type Repository interface {
+	CreateUser(ctx context.Context, user *User) error
+	ExistsUserID(ctx context.Context, userID vo.UserID) (bool, error)
+	ExistsEmail(ctx context.Context, email vo.Email) (bool, error)
+}
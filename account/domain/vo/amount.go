@@ -0,0 +1,23 @@
+package vo
+
+import "golang.org/x/xerrors"
+
+// Amount is an amount of money in yen.
+type Amount int
+
+const (
+	minAmount = 0
+	maxAmount = 1000000000
+)
+
+func NewAmount(amount int) (Amount, error) {
+	if amount < minAmount || amount > maxAmount {
+		return 0, xerrors.Errorf("amount must be %d or more and %d or less: %d", minAmount, maxAmount, amount)
+	}
+
+	return Amount(amount), nil
+}
+
+func (a Amount) Value() int {
+	return int(a)
+}
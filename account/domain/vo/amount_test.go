@@ -0,0 +1,29 @@
+package vo
+
+import "testing"
+
+func TestNewAmount(t *testing.T) {
+	tests := []struct {
+		name    string
+		amount  int
+		wantErr bool
+	}{
+		{name: "negative", amount: -1, wantErr: true},
+		{name: "minimum", amount: minAmount, wantErr: false},
+		{name: "maximum", amount: maxAmount, wantErr: false},
+		{name: "over the maximum", amount: maxAmount + 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewAmount(tt.amount)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewAmount(%d) error = %v, wantErr %t", tt.amount, err, tt.wantErr)
+			}
+
+			if err == nil && got.Value() != tt.amount {
+				t.Errorf("NewAmount(%d).Value() = %d, want %d", tt.amount, got.Value(), tt.amount)
+			}
+		})
+	}
+}
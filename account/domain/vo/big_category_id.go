@@ -0,0 +1,19 @@
+package vo
+
+import "golang.org/x/xerrors"
+
+type BigCategoryID int
+
+const minBigCategoryID = 1
+
+func NewBigCategoryID(bigCategoryID int) (BigCategoryID, error) {
+	if bigCategoryID < minBigCategoryID {
+		return 0, xerrors.Errorf("big category id must be %d or more: %d", minBigCategoryID, bigCategoryID)
+	}
+
+	return BigCategoryID(bigCategoryID), nil
+}
+
+func (i BigCategoryID) Value() int {
+	return int(i)
+}
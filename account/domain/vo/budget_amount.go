@@ -0,0 +1,19 @@
+package vo
+
+import "golang.org/x/xerrors"
+
+// BudgetAmount is a budget in yen, which has the same bounds as Amount.
+// the budgets share the bounds, so that any budget can be reached by a transaction.
+type BudgetAmount int
+
+func NewBudgetAmount(budget int) (BudgetAmount, error) {
+	if budget < minAmount || budget > maxAmount {
+		return 0, xerrors.Errorf("budget must be %d or more and %d or less: %d", minAmount, maxAmount, budget)
+	}
+
+	return BudgetAmount(budget), nil
+}
+
+func (b BudgetAmount) Value() int {
+	return int(b)
+}
@@ -0,0 +1,29 @@
+package vo
+
+import "testing"
+
+func TestNewBudgetAmount(t *testing.T) {
+	tests := []struct {
+		name    string
+		budget  int
+		wantErr bool
+	}{
+		{name: "negative", budget: -1, wantErr: true},
+		{name: "minimum", budget: minAmount, wantErr: false},
+		{name: "maximum", budget: maxAmount, wantErr: false},
+		{name: "over the maximum", budget: maxAmount + 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewBudgetAmount(tt.budget)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewBudgetAmount(%d) error = %v, wantErr %t", tt.budget, err, tt.wantErr)
+			}
+
+			if err == nil && got.Value() != tt.budget {
+				t.Errorf("NewBudgetAmount(%d).Value() = %d, want %d", tt.budget, got.Value(), tt.budget)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package vo
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/xerrors"
+)
+
+type CategoryName string
+
+const (
+	minCategoryNameLength = 1
+	maxCategoryNameLength = 50
+)
+
+func NewCategoryName(name string) (CategoryName, error) {
+	if n := utf8.RuneCountInString(name); n < minCategoryNameLength || n > maxCategoryNameLength {
+		return "", xerrors.Errorf("category name must be %d or more and %d or less: %s", minCategoryNameLength, maxCategoryNameLength, name)
+	}
+
+	if strings.TrimSpace(strings.ReplaceAll(name, "　", " ")) == "" {
+		return "", xerrors.Errorf("category name cannot consist of spaces only: %s", name)
+	}
+
+	return CategoryName(name), nil
+}
+
+func (n CategoryName) Value() string {
+	return string(n)
+}
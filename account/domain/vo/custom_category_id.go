@@ -0,0 +1,18 @@
+package vo
+
+import "golang.org/x/xerrors"
+
+// CustomCategoryID is 0 if the custom category is not set.
+type CustomCategoryID int
+
+func NewCustomCategoryID(customCategoryID int) (CustomCategoryID, error) {
+	if customCategoryID < 0 {
+		return 0, xerrors.Errorf("custom category id must be 0 or more: %d", customCategoryID)
+	}
+
+	return CustomCategoryID(customCategoryID), nil
+}
+
+func (i CustomCategoryID) Value() int {
+	return int(i)
+}
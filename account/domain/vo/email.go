@@ -0,0 +1,30 @@
+package vo
+
+import (
+	"net/mail"
+	"unicode/utf8"
+
+	"golang.org/x/xerrors"
+)
+
+type Email string
+
+const maxEmailLength = 256
+
+func NewEmail(email string) (Email, error) {
+	if n := utf8.RuneCountInString(email); n > maxEmailLength {
+		return "", xerrors.Errorf("email must be %d or less: %s", maxEmailLength, email)
+	}
+
+	// mail.ParseAddress also accepts "name <address>" forms, so only a bare address is allowed.
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email {
+		return "", xerrors.Errorf("email is not a valid address: %s", email)
+	}
+
+	return Email(email), nil
+}
+
+func (e Email) Value() string {
+	return string(e)
+}
@@ -0,0 +1,31 @@
+package vo
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/xerrors"
+)
+
+type GroupName string
+
+const (
+	minGroupNameLength = 1
+	maxGroupNameLength = 20
+)
+
+func NewGroupName(name string) (GroupName, error) {
+	if n := utf8.RuneCountInString(name); n < minGroupNameLength || n > maxGroupNameLength {
+		return "", xerrors.Errorf("group name must be %d or more and %d or less: %s", minGroupNameLength, maxGroupNameLength, name)
+	}
+
+	if strings.TrimSpace(strings.ReplaceAll(name, "　", " ")) == "" {
+		return "", xerrors.Errorf("group name cannot consist of spaces only: %s", name)
+	}
+
+	return GroupName(name), nil
+}
+
+func (n GroupName) Value() string {
+	return string(n)
+}
@@ -0,0 +1,18 @@
+package vo
+
+import "golang.org/x/xerrors"
+
+// MediumCategoryID is 0 if the medium category is not set.
+type MediumCategoryID int
+
+func NewMediumCategoryID(mediumCategoryID int) (MediumCategoryID, error) {
+	if mediumCategoryID < 0 {
+		return 0, xerrors.Errorf("medium category id must be 0 or more: %d", mediumCategoryID)
+	}
+
+	return MediumCategoryID(mediumCategoryID), nil
+}
+
+func (i MediumCategoryID) Value() int {
+	return int(i)
+}
@@ -0,0 +1,24 @@
+package vo
+
+import (
+	"unicode/utf8"
+
+	"golang.org/x/xerrors"
+)
+
+// Memo is optional, so the empty value means that no memo is recorded.
+type Memo string
+
+const maxMemoLength = 50
+
+func NewMemo(memo string) (Memo, error) {
+	if n := utf8.RuneCountInString(memo); n > maxMemoLength {
+		return "", xerrors.Errorf("memo must be %d or less: %s", maxMemoLength, memo)
+	}
+
+	return Memo(memo), nil
+}
+
+func (m Memo) Value() string {
+	return string(m)
+}
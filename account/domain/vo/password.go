@@ -0,0 +1,37 @@
+package vo
+
+import (
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/xerrors"
+)
+
+// Password holds a bcrypt hash, never the plain text.
+type Password string
+
+const (
+	minPasswordLength = 8
+	maxPasswordLength = 50
+)
+
+func NewPassword(password string) (Password, error) {
+	if n := len(password); n < minPasswordLength || n > maxPasswordLength {
+		return "", xerrors.Errorf("password must be %d or more and %d or less bytes", minPasswordLength, maxPasswordLength)
+	}
+
+	for _, r := range password {
+		if r < '!' || r > '~' {
+			return "", xerrors.New("password can contain only printable ascii characters except spaces")
+		}
+	}
+
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", xerrors.Errorf("failed to hash password: %w", err)
+	}
+
+	return Password(hashedPassword), nil
+}
+
+func (p Password) Value() string {
+	return string(p)
+}
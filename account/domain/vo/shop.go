@@ -0,0 +1,24 @@
+package vo
+
+import (
+	"unicode/utf8"
+
+	"golang.org/x/xerrors"
+)
+
+// Shop is optional, so the empty value means that no shop is recorded.
+type Shop string
+
+const maxShopLength = 20
+
+func NewShop(shop string) (Shop, error) {
+	if n := utf8.RuneCountInString(shop); n > maxShopLength {
+		return "", xerrors.Errorf("shop must be %d or less: %s", maxShopLength, shop)
+	}
+
+	return Shop(shop), nil
+}
+
+func (s Shop) Value() string {
+	return string(s)
+}
@@ -0,0 +1,31 @@
+package vo
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/xerrors"
+)
+
+type ShoppingItemName string
+
+const (
+	minShoppingItemNameLength = 1
+	maxShoppingItemNameLength = 50
+)
+
+func NewShoppingItemName(name string) (ShoppingItemName, error) {
+	if n := utf8.RuneCountInString(name); n < minShoppingItemNameLength || n > maxShoppingItemNameLength {
+		return "", xerrors.Errorf("shopping item name must be %d or more and %d or less: %s", minShoppingItemNameLength, maxShoppingItemNameLength, name)
+	}
+
+	if strings.TrimSpace(strings.ReplaceAll(name, "　", " ")) == "" {
+		return "", xerrors.Errorf("shopping item name cannot consist of spaces only: %s", name)
+	}
+
+	return ShoppingItemName(name), nil
+}
+
+func (n ShoppingItemName) Value() string {
+	return string(n)
+}
@@ -0,0 +1,31 @@
+package vo
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/xerrors"
+)
+
+type TodoContent string
+
+const (
+	minTodoContentLength = 1
+	maxTodoContentLength = 100
+)
+
+func NewTodoContent(content string) (TodoContent, error) {
+	if n := utf8.RuneCountInString(content); n < minTodoContentLength || n > maxTodoContentLength {
+		return "", xerrors.Errorf("todo content must be %d or more and %d or less: %s", minTodoContentLength, maxTodoContentLength, content)
+	}
+
+	if strings.TrimSpace(strings.ReplaceAll(content, "　", " ")) == "" {
+		return "", xerrors.Errorf("todo content cannot consist of spaces only: %s", content)
+	}
+
+	return TodoContent(content), nil
+}
+
+func (c TodoContent) Value() string {
+	return string(c)
+}
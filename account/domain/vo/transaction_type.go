@@ -0,0 +1,23 @@
+package vo
+
+import "golang.org/x/xerrors"
+
+type TransactionType int
+
+const (
+	TransactionTypeIncome  TransactionType = 1
+	TransactionTypeExpense TransactionType = 2
+)
+
+func NewTransactionType(transactionType int) (TransactionType, error) {
+	switch t := TransactionType(transactionType); t {
+	case TransactionTypeIncome, TransactionTypeExpense:
+		return t, nil
+	default:
+		return 0, xerrors.Errorf("transaction type must be %d or %d: %d", TransactionTypeIncome, TransactionTypeExpense, transactionType)
+	}
+}
+
+func (t TransactionType) Value() int {
+	return int(t)
+}
@@ -1,7 +1,8 @@
 package vo
 
 import (
-	"strings"
+	"regexp"
+	"unicode"
 	"unicode/utf8"
 
 	"golang.org/x/xerrors"
@@ -14,13 +15,63 @@ const (
 	maxUserIDLength = 10
 )
 
+// DefaultUserIDPattern allows letters and numbers of any script, and "_", "." and "-".
+const DefaultUserIDPattern = `^[\p{L}\p{M}\p{N}_.\-]+$`
+
+var userIDPattern = regexp.MustCompile(DefaultUserIDPattern)
+
+// SetUserIDPattern replaces the characters allowed in user ids. it is meant to be called once at startup,
+// and whitespace and control characters are rejected whatever the pattern is.
+// the pattern must match the whole user id, so it is anchored even if it has no "^" and "$".
+func SetUserIDPattern(pattern string) error {
+	p, err := regexp.Compile(`^(?:` + pattern + `)$`)
+	if err != nil {
+		return xerrors.Errorf("user id pattern is not a valid regular expression: %w", err)
+	}
+
+	userIDPattern = p
+
+	return nil
+}
+
+// NewUserID returns *ValidationError with all the violated rules if the user id is invalid.
 func NewUserID(userID string) (UserID, error) {
+	validationErr := &ValidationError{}
+
+	if !utf8.ValidString(userID) {
+		validationErr.add("user id must be valid UTF-8")
+
+		return "", validationErr
+	}
+
 	if n := utf8.RuneCountInString(userID); n < minUserIDLength || n > maxUserIDLength {
-		return "", xerrors.Errorf("user id must be %d or more and %d or less: %s", minUserIDLength, maxUserIDLength, userID)
+		validationErr.add("user id must be %d or more and %d or less: %q", minUserIDLength, maxUserIDLength, userID)
+	}
+
+	var hasSpace, hasControl bool
+	for _, r := range userID {
+		switch {
+		case unicode.IsSpace(r):
+			hasSpace = true
+		case unicode.IsControl(r) || unicode.Is(unicode.Cf, r):
+			hasControl = true
+		}
+	}
+
+	if hasSpace {
+		validationErr.add("user id cannot contain whitespace: %q", userID)
+	}
+
+	if hasControl {
+		validationErr.add("user id cannot contain control characters: %q", userID)
+	}
+
+	if !hasSpace && !hasControl && userID != "" && !userIDPattern.MatchString(userID) {
+		validationErr.add("user id contains characters which are not allowed: %q", userID)
 	}
 
-	if strings.Contains(userID, " ") || strings.Contains(userID, "　") {
-		return "", xerrors.Errorf("user id cannot contain spaces: %s", userID)
+	if err := validationErr.errorOrNil(); err != nil {
+		return "", err
 	}
 
 	return UserID(userID), nil
@@ -0,0 +1,140 @@
+package vo
+
+import (
+	"testing"
+
+	"golang.org/x/xerrors"
+)
+
+func TestSetUserIDPattern(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		userID  string
+		wantErr bool
+	}{
+		{name: "unanchored pattern matches the whole id", pattern: `[a-z]+`, userID: "alice", wantErr: false},
+		{name: "unanchored pattern rejects a disallowed suffix", pattern: `[a-z]+`, userID: "alice!", wantErr: true},
+		{name: "unanchored pattern rejects a disallowed prefix", pattern: `[a-z]+`, userID: "!alice", wantErr: true},
+		{name: "alternation is anchored as a whole", pattern: `a+|b+`, userID: "aab", wantErr: true},
+		{name: "anchored pattern", pattern: `^[a-z]+$`, userID: "alice", wantErr: false},
+		{name: "anchored pattern rejects a disallowed character", pattern: `^[a-z]+$`, userID: "alice1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := SetUserIDPattern(tt.pattern); err != nil {
+				t.Fatalf("SetUserIDPattern(%q) error = %v", tt.pattern, err)
+			}
+			t.Cleanup(func() {
+				if err := SetUserIDPattern(DefaultUserIDPattern); err != nil {
+					t.Fatalf("SetUserIDPattern(DefaultUserIDPattern) error = %v", err)
+				}
+			})
+
+			if _, err := NewUserID(tt.userID); (err != nil) != tt.wantErr {
+				t.Errorf("NewUserID(%q) error = %v, wantErr %t", tt.userID, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSetUserIDPatternInvalid(t *testing.T) {
+	if err := SetUserIDPattern(`[a-z`); err == nil {
+		t.Errorf("SetUserIDPattern() error = nil, want an error")
+	}
+
+	if _, err := NewUserID("alice"); err != nil {
+		t.Errorf("NewUserID() error = %v after an invalid pattern, want the pattern kept", err)
+	}
+}
+
+func TestNewUserID(t *testing.T) {
+	tests := []struct {
+		name           string
+		userID         string
+		wantViolations []string
+	}{
+		{name: "ascii", userID: "alice_01.a", wantViolations: nil},
+		{name: "japanese", userID: "たなか", wantViolations: nil},
+		{name: "combining mark", userID: "é", wantViolations: nil},
+		{name: "empty", userID: "", wantViolations: []string{
+			`user id must be 1 or more and 10 or less: ""`,
+		}},
+		{name: "10 runes", userID: "あいうえおかきくけこ", wantViolations: nil},
+		{name: "11 runes", userID: "あいうえおかきくけこさ", wantViolations: []string{
+			`user id must be 1 or more and 10 or less: "あいうえおかきくけこさ"`,
+		}},
+		{name: "space", userID: "ali ce", wantViolations: []string{
+			`user id cannot contain whitespace: "ali ce"`,
+		}},
+		{name: "tab", userID: "ali\tce", wantViolations: []string{
+			`user id cannot contain whitespace: "ali\tce"`,
+		}},
+		{name: "no-break space", userID: "ali\u00a0ce", wantViolations: []string{
+			`user id cannot contain whitespace: "ali\u00a0ce"`,
+		}},
+		{name: "ideographic space", userID: "ali\u3000ce", wantViolations: []string{
+			`user id cannot contain whitespace: "ali\u3000ce"`,
+		}},
+		{name: "control character", userID: "ali\x00ce", wantViolations: []string{
+			`user id cannot contain control characters: "ali\x00ce"`,
+		}},
+		{name: "escape character", userID: "ali\x1bce", wantViolations: []string{
+			`user id cannot contain control characters: "ali\x1bce"`,
+		}},
+		{name: "zero width joiner", userID: "ali\u200dce", wantViolations: []string{
+			`user id cannot contain control characters: "ali\u200dce"`,
+		}},
+		{name: "right-to-left override", userID: "ali\u202ece", wantViolations: []string{
+			`user id cannot contain control characters: "ali\u202ece"`,
+		}},
+		{name: "emoji", userID: "ali😀", wantViolations: []string{
+			`user id contains characters which are not allowed: "ali😀"`,
+		}},
+		{name: "symbol", userID: "ali!ce", wantViolations: []string{
+			`user id contains characters which are not allowed: "ali!ce"`,
+		}},
+		{name: "invalid utf-8", userID: "ali\xffce", wantViolations: []string{
+			"user id must be valid UTF-8",
+		}},
+		{name: "every violation at once", userID: "あいうえお かきくけ\u200d", wantViolations: []string{
+			`user id must be 1 or more and 10 or less: "あいうえお かきくけ\u200d"`,
+			`user id cannot contain whitespace: "あいうえお かきくけ\u200d"`,
+			`user id cannot contain control characters: "あいうえお かきくけ\u200d"`,
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewUserID(tt.userID)
+			if tt.wantViolations == nil {
+				if err != nil {
+					t.Fatalf("NewUserID(%q) error = %v, want nil", tt.userID, err)
+				}
+
+				if got.Value() != tt.userID {
+					t.Errorf("NewUserID(%q).Value() = %q, want %q", tt.userID, got.Value(), tt.userID)
+				}
+
+				return
+			}
+
+			var validationErr *ValidationError
+			if !xerrors.As(err, &validationErr) {
+				t.Fatalf("NewUserID(%q) error = %v, want *ValidationError", tt.userID, err)
+			}
+
+			violations := validationErr.Violations()
+			if len(violations) != len(tt.wantViolations) {
+				t.Fatalf("Violations() = %q, want %q", violations, tt.wantViolations)
+			}
+
+			for i := range tt.wantViolations {
+				if violations[i] != tt.wantViolations[i] {
+					t.Errorf("Violations()[%d] = %q, want %q", i, violations[i], tt.wantViolations[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package vo
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/xerrors"
+)
+
+type UserName string
+
+const (
+	minUserNameLength = 1
+	maxUserNameLength = 50
+)
+
+func NewUserName(name string) (UserName, error) {
+	if n := utf8.RuneCountInString(name); n < minUserNameLength || n > maxUserNameLength {
+		return "", xerrors.Errorf("user name must be %d or more and %d or less: %s", minUserNameLength, maxUserNameLength, name)
+	}
+
+	if strings.TrimSpace(strings.ReplaceAll(name, "　", " ")) == "" {
+		return "", xerrors.Errorf("user name cannot consist of spaces only: %s", name)
+	}
+
+	return UserName(name), nil
+}
+
+func (n UserName) Value() string {
+	return string(n)
+}
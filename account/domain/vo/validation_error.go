@@ -0,0 +1,31 @@
+package vo
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ValidationError holds every rule which a value violates, so that all of them can be reported at once.
+type ValidationError struct {
+	violations []string
+}
+
+func (e *ValidationError) Error() string {
+	return strings.Join(e.violations, ", ")
+}
+
+func (e *ValidationError) Violations() []string {
+	return e.violations
+}
+
+func (e *ValidationError) add(format string, args ...interface{}) {
+	e.violations = append(e.violations, fmt.Sprintf(format, args...))
+}
+
+func (e *ValidationError) errorOrNil() error {
+	if len(e.violations) == 0 {
+		return nil
+	}
+
+	return e
+}
@@ -0,0 +1,44 @@
+package vo
+
+import (
+	"time"
+
+	"golang.org/x/xerrors"
+)
+
+type YearMonth struct {
+	time time.Time
+}
+
+const yearMonthLayout = "2006-01"
+
+func NewYearMonth(yearMonth string) (YearMonth, error) {
+	t, err := time.Parse(yearMonthLayout, yearMonth)
+	if err != nil {
+		return YearMonth{}, xerrors.Errorf("year month must be in YYYY-MM format: %s", yearMonth)
+	}
+
+	return YearMonth{time: t}, nil
+}
+
+// Value returns the first day of the month in UTC.
+func (ym YearMonth) Value() time.Time {
+	return ym.time
+}
+
+// LastDay returns the last day of the month in UTC.
+func (ym YearMonth) LastDay() time.Time {
+	return ym.time.AddDate(0, 1, -1)
+}
+
+func (ym YearMonth) Next() YearMonth {
+	return YearMonth{time: ym.time.AddDate(0, 1, 0)}
+}
+
+func (ym YearMonth) Prev() YearMonth {
+	return YearMonth{time: ym.time.AddDate(0, -1, 0)}
+}
+
+func (ym YearMonth) String() string {
+	return ym.time.Format(yearMonthLayout)
+}
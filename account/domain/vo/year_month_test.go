@@ -0,0 +1,121 @@
+package vo
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewYearMonth(t *testing.T) {
+	tests := []struct {
+		name      string
+		yearMonth string
+		wantErr   bool
+	}{
+		{name: "valid", yearMonth: "2021-05", wantErr: false},
+		{name: "month 13", yearMonth: "2021-13", wantErr: true},
+		{name: "with a day", yearMonth: "2021-05-01", wantErr: true},
+		{name: "without zero padding", yearMonth: "2021-5", wantErr: true},
+		{name: "empty", yearMonth: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := NewYearMonth(tt.yearMonth); (err != nil) != tt.wantErr {
+				t.Errorf("NewYearMonth(%q) error = %v, wantErr %t", tt.yearMonth, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestYearMonth(t *testing.T) {
+	tests := []struct {
+		name      string
+		yearMonth string
+		wantNext  string
+		wantPrev  string
+		wantValue time.Time
+		wantLast  time.Time
+	}{
+		{
+			name:      "middle of the year",
+			yearMonth: "2021-05",
+			wantNext:  "2021-06",
+			wantPrev:  "2021-04",
+			wantValue: time.Date(2021, time.May, 1, 0, 0, 0, 0, time.UTC),
+			wantLast:  time.Date(2021, time.May, 31, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "December rolls over to the next year",
+			yearMonth: "2021-12",
+			wantNext:  "2022-01",
+			wantPrev:  "2021-11",
+			wantValue: time.Date(2021, time.December, 1, 0, 0, 0, 0, time.UTC),
+			wantLast:  time.Date(2021, time.December, 31, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "January rolls back to the previous year",
+			yearMonth: "2022-01",
+			wantNext:  "2022-02",
+			wantPrev:  "2021-12",
+			wantValue: time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC),
+			wantLast:  time.Date(2022, time.January, 31, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "April has 30 days",
+			yearMonth: "2021-04",
+			wantNext:  "2021-05",
+			wantPrev:  "2021-03",
+			wantValue: time.Date(2021, time.April, 1, 0, 0, 0, 0, time.UTC),
+			wantLast:  time.Date(2021, time.April, 30, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "February in a common year",
+			yearMonth: "2021-02",
+			wantNext:  "2021-03",
+			wantPrev:  "2021-01",
+			wantValue: time.Date(2021, time.February, 1, 0, 0, 0, 0, time.UTC),
+			wantLast:  time.Date(2021, time.February, 28, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "February in a leap year",
+			yearMonth: "2020-02",
+			wantNext:  "2020-03",
+			wantPrev:  "2020-01",
+			wantValue: time.Date(2020, time.February, 1, 0, 0, 0, 0, time.UTC),
+			wantLast:  time.Date(2020, time.February, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "February in a century year which is not a leap year",
+			yearMonth: "2100-02",
+			wantNext:  "2100-03",
+			wantPrev:  "2100-01",
+			wantValue: time.Date(2100, time.February, 1, 0, 0, 0, 0, time.UTC),
+			wantLast:  time.Date(2100, time.February, 28, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ym, err := NewYearMonth(tt.yearMonth)
+			if err != nil {
+				t.Fatalf("NewYearMonth(%q) error = %v", tt.yearMonth, err)
+			}
+
+			if got := ym.Next().String(); got != tt.wantNext {
+				t.Errorf("Next() = %s, want %s", got, tt.wantNext)
+			}
+
+			if got := ym.Prev().String(); got != tt.wantPrev {
+				t.Errorf("Prev() = %s, want %s", got, tt.wantPrev)
+			}
+
+			if got := ym.Value(); !got.Equal(tt.wantValue) {
+				t.Errorf("Value() = %s, want %s", got, tt.wantValue)
+			}
+
+			if got := ym.LastDay(); !got.Equal(tt.wantLast) {
+				t.Errorf("LastDay() = %s, want %s", got, tt.wantLast)
+			}
+		})
+	}
+}
@@ -1,13 +1,34 @@
 package persistence
 
 import (
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"context"
+	"strings"
 
+	"github.com/paypay3/tukecholl-api/account/apperror"
+	"github.com/paypay3/tukecholl-api/account/domain/budgetdomain"
 	"github.com/paypay3/tukecholl-api/account/domain/vo"
 	"github.com/paypay3/tukecholl-api/account/infrastructure/persistence/rdb"
 )
 
+type standardBudgetDto struct {
+	BigCategoryID   int    `db:"big_category_id"`
+	BigCategoryName string `db:"big_category_name"`
+	Budget          int    `db:"budget"`
+}
+
+type customBudgetDto struct {
+	BigCategoryID   int    `db:"big_category_id"`
+	BigCategoryName string `db:"big_category_name"`
+	Budget          int    `db:"budget"`
+}
+
+type monthlyCustomBudgetDto struct {
+	YearMonth       string `db:"years_months"`
+	BigCategoryID   int    `db:"big_category_id"`
+	BigCategoryName string `db:"big_category_name"`
+	Budget          int    `db:"budget"`
+}
+
 type budgetRepository struct {
 	*rdb.Driver
 }
@@ -16,30 +37,304 @@ func NewBudgetRepository(rdbDriver *rdb.Driver) *budgetRepository {
 	return &budgetRepository{rdbDriver}
 }
 
-func (r *budgetRepository) CreateStandardBudgets(userID vo.UserID) error {
+func (r *budgetRepository) CreateStandardBudgets(ctx context.Context, userID vo.UserID, bigCategoryIDs []vo.BigCategoryID) error {
 	query := `
         INSERT INTO standard_budgets
             (user_id, big_category_id)
         VALUES
-            (?,2),
-            (?,3),
-            (?,4),
-            (?,5),
-            (?,6),
-            (?,7),
-            (?,8),
-            (?,9),
-            (?,10),
-            (?,11),
-            (?,12),
-            (?,13),
-            (?,14),
-            (?,15),
-            (?,16),
-            (?,17)`
-
-	if _, err := r.Driver.Conn.Exec(query, userID, userID, userID, userID, userID, userID, userID, userID, userID, userID, userID, userID, userID, userID, userID, userID); err != nil {
-		return status.Errorf(codes.Internal, "rdb unexpected error: %v", err)
+            ` + strings.TrimSuffix(strings.Repeat("(?,?),", len(bigCategoryIDs)), ",") + `
+        ON DUPLICATE KEY UPDATE
+            big_category_id = big_category_id`
+
+	args := make([]interface{}, 0, len(bigCategoryIDs)*2)
+	for _, bigCategoryID := range bigCategoryIDs {
+		args = append(args, userID, bigCategoryID)
+	}
+
+	if _, err := r.Driver.Executor(ctx).ExecContext(ctx, query, args...); err != nil {
+		return apperror.NewInternalError(err)
+	}
+
+	return nil
+}
+
+func (r *budgetRepository) GetStandardBudgets(ctx context.Context, userID vo.UserID) ([]*budgetdomain.StandardBudget, error) {
+	query := `
+        SELECT
+            standard_budgets.big_category_id,
+            big_categories.category_name big_category_name,
+            standard_budgets.budget
+        FROM
+            standard_budgets
+        INNER JOIN
+            big_categories
+        ON
+            standard_budgets.big_category_id = big_categories.id
+        WHERE
+            standard_budgets.user_id = ?
+        ORDER BY
+            standard_budgets.big_category_id`
+
+	var standardBudgetsDto []standardBudgetDto
+	if err := r.Driver.Executor(ctx).SelectContext(ctx, &standardBudgetsDto, query, userID); err != nil {
+		return nil, apperror.NewInternalError(err)
+	}
+
+	if len(standardBudgetsDto) == 0 {
+		return nil, apperror.NewNotFoundError(apperror.ReasonStandardBudgetsNotFound, "standard budgets not found: %s", userID)
+	}
+
+	standardBudgets := make([]*budgetdomain.StandardBudget, len(standardBudgetsDto))
+	for i, dto := range standardBudgetsDto {
+		standardBudgets[i] = budgetdomain.NewStandardBudget(vo.BigCategoryID(dto.BigCategoryID), dto.BigCategoryName, vo.BudgetAmount(dto.Budget))
+	}
+
+	return standardBudgets, nil
+}
+
+func (r *budgetRepository) EditStandardBudgets(ctx context.Context, userID vo.UserID, standardBudgets []*budgetdomain.StandardBudget) error {
+	query := `
+        UPDATE
+            standard_budgets
+        SET
+            budget = ?
+        WHERE
+            user_id = ?
+        AND
+            big_category_id = ?`
+
+	for _, standardBudget := range standardBudgets {
+		if _, err := r.Driver.Executor(ctx).ExecContext(ctx, query, standardBudget.Budget(), userID, standardBudget.BigCategoryID()); err != nil {
+			return apperror.NewInternalError(err)
+		}
+	}
+
+	return nil
+}
+
+func (r *budgetRepository) CreateCustomBudgets(ctx context.Context, userID vo.UserID, yearMonth vo.YearMonth, customBudgets []*budgetdomain.CustomBudget) error {
+	query := `
+        INSERT INTO custom_budgets
+            (user_id, years_months, big_category_id, budget)
+        VALUES
+            ` + strings.TrimSuffix(strings.Repeat("(?,?,?,?),", len(customBudgets)), ",")
+
+	args := make([]interface{}, 0, len(customBudgets)*4)
+	for _, customBudget := range customBudgets {
+		args = append(args, userID, yearMonth.Value(), customBudget.BigCategoryID(), customBudget.Budget())
+	}
+
+	if _, err := r.Driver.Executor(ctx).ExecContext(ctx, query, args...); err != nil {
+		if rdb.IsDuplicateEntryError(err) {
+			return apperror.NewConflictError(apperror.ReasonCustomBudgetsAlreadyExist, "custom budgets already exist: %s %s", userID, yearMonth)
+		}
+
+		return apperror.NewInternalError(err)
+	}
+
+	return nil
+}
+
+func (r *budgetRepository) GetCustomBudgets(ctx context.Context, userID vo.UserID, yearMonth vo.YearMonth) ([]*budgetdomain.CustomBudget, error) {
+	query := `
+        SELECT
+            custom_budgets.big_category_id,
+            big_categories.category_name big_category_name,
+            custom_budgets.budget
+        FROM
+            custom_budgets
+        INNER JOIN
+            big_categories
+        ON
+            custom_budgets.big_category_id = big_categories.id
+        WHERE
+            custom_budgets.user_id = ?
+        AND
+            custom_budgets.years_months = ?
+        ORDER BY
+            custom_budgets.big_category_id`
+
+	var customBudgetsDto []customBudgetDto
+	if err := r.Driver.Executor(ctx).SelectContext(ctx, &customBudgetsDto, query, userID, yearMonth.Value()); err != nil {
+		return nil, apperror.NewInternalError(err)
+	}
+
+	if len(customBudgetsDto) == 0 {
+		return nil, apperror.NewNotFoundError(apperror.ReasonCustomBudgetsNotFound, "custom budgets not found: %s %s", userID, yearMonth)
+	}
+
+	customBudgets := make([]*budgetdomain.CustomBudget, len(customBudgetsDto))
+	for i, dto := range customBudgetsDto {
+		customBudgets[i] = budgetdomain.NewCustomBudget(vo.BigCategoryID(dto.BigCategoryID), dto.BigCategoryName, vo.BudgetAmount(dto.Budget))
+	}
+
+	return customBudgets, nil
+}
+
+func (r *budgetRepository) EditCustomBudgets(ctx context.Context, userID vo.UserID, yearMonth vo.YearMonth, customBudgets []*budgetdomain.CustomBudget) error {
+	query := `
+        UPDATE
+            custom_budgets
+        SET
+            budget = ?
+        WHERE
+            user_id = ?
+        AND
+            years_months = ?
+        AND
+            big_category_id = ?`
+
+	for _, customBudget := range customBudgets {
+		if _, err := r.Driver.Executor(ctx).ExecContext(ctx, query, customBudget.Budget(), userID, yearMonth.Value(), customBudget.BigCategoryID()); err != nil {
+			return apperror.NewInternalError(err)
+		}
+	}
+
+	return nil
+}
+
+func (r *budgetRepository) DeleteCustomBudgets(ctx context.Context, userID vo.UserID, yearMonth vo.YearMonth) error {
+	query := `
+        DELETE FROM
+            custom_budgets
+        WHERE
+            user_id = ?
+        AND
+            years_months = ?`
+
+	result, err := r.Driver.Executor(ctx).ExecContext(ctx, query, userID, yearMonth.Value())
+	if err != nil {
+		return apperror.NewInternalError(err)
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return apperror.NewInternalError(err)
+	}
+
+	if n == 0 {
+		return apperror.NewNotFoundError(apperror.ReasonCustomBudgetsNotFound, "custom budgets not found: %s %s", userID, yearMonth)
+	}
+
+	return nil
+}
+
+func (r *budgetRepository) GetMonthlyCustomBudgetsList(ctx context.Context, userID vo.UserID, from, to vo.YearMonth) ([]*budgetdomain.MonthlyCustomBudgets, error) {
+	query := `
+        SELECT
+            DATE_FORMAT(custom_budgets.years_months, '%Y-%m') years_months,
+            custom_budgets.big_category_id,
+            big_categories.category_name big_category_name,
+            custom_budgets.budget
+        FROM
+            custom_budgets
+        INNER JOIN
+            big_categories
+        ON
+            custom_budgets.big_category_id = big_categories.id
+        WHERE
+            custom_budgets.user_id = ?
+        AND
+            custom_budgets.years_months BETWEEN ? AND ?
+        ORDER BY
+            custom_budgets.years_months, custom_budgets.big_category_id`
+
+	var monthlyCustomBudgetsDto []monthlyCustomBudgetDto
+	if err := r.Driver.Executor(ctx).SelectContext(ctx, &monthlyCustomBudgetsDto, query, userID, from.Value(), to.Value()); err != nil {
+		return nil, apperror.NewInternalError(err)
+	}
+
+	var monthlyCustomBudgetsList []*budgetdomain.MonthlyCustomBudgets
+	var customBudgets []*budgetdomain.CustomBudget
+	for i, dto := range monthlyCustomBudgetsDto {
+		customBudgets = append(customBudgets, budgetdomain.NewCustomBudget(vo.BigCategoryID(dto.BigCategoryID), dto.BigCategoryName, vo.BudgetAmount(dto.Budget)))
+
+		if i < len(monthlyCustomBudgetsDto)-1 && monthlyCustomBudgetsDto[i+1].YearMonth == dto.YearMonth {
+			continue
+		}
+
+		yearMonth, err := vo.NewYearMonth(dto.YearMonth)
+		if err != nil {
+			return nil, apperror.NewInternalError(err)
+		}
+
+		monthlyCustomBudgetsList = append(monthlyCustomBudgetsList, budgetdomain.NewMonthlyCustomBudgets(yearMonth, customBudgets))
+		customBudgets = nil
+	}
+
+	return monthlyCustomBudgetsList, nil
+}
+
+func (r *budgetRepository) CreateGroupStandardBudgets(ctx context.Context, groupID int, bigCategoryIDs []vo.BigCategoryID) error {
+	query := `
+        INSERT INTO group_standard_budgets
+            (group_id, big_category_id)
+        VALUES
+            ` + strings.TrimSuffix(strings.Repeat("(?,?),", len(bigCategoryIDs)), ",") + `
+        ON DUPLICATE KEY UPDATE
+            big_category_id = big_category_id`
+
+	args := make([]interface{}, 0, len(bigCategoryIDs)*2)
+	for _, bigCategoryID := range bigCategoryIDs {
+		args = append(args, groupID, bigCategoryID)
+	}
+
+	if _, err := r.Driver.Executor(ctx).ExecContext(ctx, query, args...); err != nil {
+		return apperror.NewInternalError(err)
+	}
+
+	return nil
+}
+
+func (r *budgetRepository) GetGroupStandardBudgets(ctx context.Context, groupID int) ([]*budgetdomain.StandardBudget, error) {
+	query := `
+        SELECT
+            group_standard_budgets.big_category_id,
+            big_categories.category_name big_category_name,
+            group_standard_budgets.budget
+        FROM
+            group_standard_budgets
+        INNER JOIN
+            big_categories
+        ON
+            group_standard_budgets.big_category_id = big_categories.id
+        WHERE
+            group_standard_budgets.group_id = ?
+        ORDER BY
+            group_standard_budgets.big_category_id`
+
+	var standardBudgetsDto []standardBudgetDto
+	if err := r.Driver.Executor(ctx).SelectContext(ctx, &standardBudgetsDto, query, groupID); err != nil {
+		return nil, apperror.NewInternalError(err)
+	}
+
+	if len(standardBudgetsDto) == 0 {
+		return nil, apperror.NewNotFoundError(apperror.ReasonGroupStandardBudgetsNotFound, "group standard budgets not found: %d", groupID)
+	}
+
+	standardBudgets := make([]*budgetdomain.StandardBudget, len(standardBudgetsDto))
+	for i, dto := range standardBudgetsDto {
+		standardBudgets[i] = budgetdomain.NewStandardBudget(vo.BigCategoryID(dto.BigCategoryID), dto.BigCategoryName, vo.BudgetAmount(dto.Budget))
+	}
+
+	return standardBudgets, nil
+}
+
+func (r *budgetRepository) EditGroupStandardBudgets(ctx context.Context, groupID int, standardBudgets []*budgetdomain.StandardBudget) error {
+	query := `
+        UPDATE
+            group_standard_budgets
+        SET
+            budget = ?
+        WHERE
+            group_id = ?
+        AND
+            big_category_id = ?`
+
+	for _, standardBudget := range standardBudgets {
+		if _, err := r.Driver.Executor(ctx).ExecContext(ctx, query, standardBudget.Budget(), groupID, standardBudget.BigCategoryID()); err != nil {
+			return apperror.NewInternalError(err)
+		}
 	}
 
 	return nil
@@ -0,0 +1,98 @@
+//go:build integration
+// +build integration
+
+// the tests run against the database of docker-compose.yml with the environment variables of the server:
+// go test -tags integration ./infrastructure/persistence/...
+package persistence
+
+import (
+	"context"
+	"testing"
+
+	"github.com/paypay3/tukecholl-api/account/domain/vo"
+	"github.com/paypay3/tukecholl-api/account/infrastructure/persistence/rdb"
+)
+
+type budgetRow struct {
+	BigCategoryID int `db:"big_category_id"`
+	Budget        int `db:"budget"`
+}
+
+func TestCreateStandardBudgetsWithExistingRows(t *testing.T) {
+	ctx := context.Background()
+
+	rdbDriver, err := rdb.NewDriver()
+	if err != nil {
+		t.Fatalf("NewDriver() error = %v", err)
+	}
+	t.Cleanup(func() {
+		rdbDriver.Conn.Close()
+	})
+
+	repository := NewBudgetRepository(rdbDriver)
+
+	// neither table has a foreign key to the owners, so owners no one uses are enough.
+	tests := []struct {
+		name        string
+		table       string
+		ownerColumn string
+		owner       interface{}
+		create      func(ctx context.Context, bigCategoryIDs []vo.BigCategoryID) error
+	}{
+		{
+			name:        "standard budgets of a user",
+			table:       "standard_budgets",
+			ownerColumn: "user_id",
+			owner:       "budgettest",
+			create: func(ctx context.Context, bigCategoryIDs []vo.BigCategoryID) error {
+				return repository.CreateStandardBudgets(ctx, "budgettest", bigCategoryIDs)
+			},
+		},
+		{
+			name:        "standard budgets of a group",
+			table:       "group_standard_budgets",
+			ownerColumn: "group_id",
+			owner:       2147483647,
+			create: func(ctx context.Context, bigCategoryIDs []vo.BigCategoryID) error {
+				return repository.CreateGroupStandardBudgets(ctx, 2147483647, bigCategoryIDs)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cleanup := func() {
+				if _, err := rdbDriver.Conn.ExecContext(ctx, `DELETE FROM `+tt.table+` WHERE `+tt.ownerColumn+` = ?`, tt.owner); err != nil {
+					t.Fatalf("failed to delete %s: %v", tt.table, err)
+				}
+			}
+			cleanup()
+			t.Cleanup(cleanup)
+
+			// the owner already has the budget of big category 2, and misses the big categories added later.
+			if _, err := rdbDriver.Conn.ExecContext(ctx, `INSERT INTO `+tt.table+` (`+tt.ownerColumn+`, big_category_id, budget) VALUES (?, 2, 30000)`, tt.owner); err != nil {
+				t.Fatalf("failed to insert into %s: %v", tt.table, err)
+			}
+
+			if err := tt.create(ctx, []vo.BigCategoryID{2, 3, 4}); err != nil {
+				t.Fatalf("create error = %v", err)
+			}
+
+			var got []budgetRow
+			if err := rdbDriver.Conn.SelectContext(ctx, &got, `SELECT big_category_id, budget FROM `+tt.table+` WHERE `+tt.ownerColumn+` = ? ORDER BY big_category_id`, tt.owner); err != nil {
+				t.Fatalf("failed to select %s: %v", tt.table, err)
+			}
+
+			want := []budgetRow{{BigCategoryID: 2, Budget: 30000}, {BigCategoryID: 3, Budget: 0}, {BigCategoryID: 4, Budget: 0}}
+			if len(got) != len(want) {
+				t.Fatalf("rows = %v, want %v", got, want)
+			}
+
+			for i := range want {
+				if got[i] != want[i] {
+					t.Errorf("rows[%d] = %v, want %v", i, got[i], want[i])
+				}
+			}
+		})
+	}
+}
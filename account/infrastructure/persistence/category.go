@@ -0,0 +1,217 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/paypay3/tukecholl-api/account/apperror"
+	"github.com/paypay3/tukecholl-api/account/domain/categorydomain"
+	"github.com/paypay3/tukecholl-api/account/domain/vo"
+	"github.com/paypay3/tukecholl-api/account/infrastructure/persistence/rdb"
+)
+
+type bigCategoryDto struct {
+	ID                int    `db:"id"`
+	Name              string `db:"category_name"`
+	TransactionTypeID int    `db:"transaction_type_id"`
+}
+
+type mediumCategoryDto struct {
+	ID            int    `db:"id"`
+	Name          string `db:"category_name"`
+	BigCategoryID int    `db:"big_category_id"`
+}
+
+type customCategoryDto struct {
+	ID            int    `db:"id"`
+	Name          string `db:"category_name"`
+	BigCategoryID int    `db:"big_category_id"`
+	UserID        string `db:"user_id"`
+}
+
+type categoryRepository struct {
+	*rdb.Driver
+}
+
+func NewCategoryRepository(rdbDriver *rdb.Driver) *categoryRepository {
+	return &categoryRepository{rdbDriver}
+}
+
+func (r *categoryRepository) GetBigCategories(ctx context.Context) ([]*categorydomain.BigCategory, error) {
+	query := `
+        SELECT
+            id,
+            category_name,
+            transaction_type_id
+        FROM
+            big_categories
+        ORDER BY
+            id`
+
+	var bigCategoriesDto []bigCategoryDto
+	if err := r.Driver.Executor(ctx).SelectContext(ctx, &bigCategoriesDto, query); err != nil {
+		return nil, apperror.NewInternalError(err)
+	}
+
+	bigCategories := make([]*categorydomain.BigCategory, len(bigCategoriesDto))
+	for i, dto := range bigCategoriesDto {
+		transactionType, err := vo.NewTransactionType(dto.TransactionTypeID)
+		if err != nil {
+			return nil, apperror.NewInternalError(err)
+		}
+
+		bigCategories[i] = categorydomain.NewBigCategory(vo.BigCategoryID(dto.ID), dto.Name, transactionType)
+	}
+
+	return bigCategories, nil
+}
+
+func (r *categoryRepository) GetMediumCategories(ctx context.Context) ([]*categorydomain.MediumCategory, error) {
+	query := `
+        SELECT
+            id,
+            category_name,
+            big_category_id
+        FROM
+            medium_categories
+        ORDER BY
+            id`
+
+	var mediumCategoriesDto []mediumCategoryDto
+	if err := r.Driver.Executor(ctx).SelectContext(ctx, &mediumCategoriesDto, query); err != nil {
+		return nil, apperror.NewInternalError(err)
+	}
+
+	mediumCategories := make([]*categorydomain.MediumCategory, len(mediumCategoriesDto))
+	for i, dto := range mediumCategoriesDto {
+		mediumCategories[i] = categorydomain.NewMediumCategory(vo.MediumCategoryID(dto.ID), dto.Name, vo.BigCategoryID(dto.BigCategoryID))
+	}
+
+	return mediumCategories, nil
+}
+
+func (r *categoryRepository) GetCustomCategories(ctx context.Context, userID vo.UserID) ([]*categorydomain.CustomCategory, error) {
+	query := `
+        SELECT
+            id,
+            category_name,
+            big_category_id,
+            user_id
+        FROM
+            custom_categories
+        WHERE
+            user_id = ?
+        ORDER BY
+            id`
+
+	var customCategoriesDto []customCategoryDto
+	if err := r.Driver.Executor(ctx).SelectContext(ctx, &customCategoriesDto, query, userID); err != nil {
+		return nil, apperror.NewInternalError(err)
+	}
+
+	customCategories := make([]*categorydomain.CustomCategory, len(customCategoriesDto))
+	for i, dto := range customCategoriesDto {
+		customCategories[i] = categorydomain.NewCustomCategory(vo.CustomCategoryID(dto.ID), vo.CategoryName(dto.Name), vo.BigCategoryID(dto.BigCategoryID), vo.UserID(dto.UserID))
+	}
+
+	return customCategories, nil
+}
+
+func (r *categoryRepository) GetCustomCategory(ctx context.Context, userID vo.UserID, customCategoryID vo.CustomCategoryID) (*categorydomain.CustomCategory, error) {
+	query := `
+        SELECT
+            id,
+            category_name,
+            big_category_id,
+            user_id
+        FROM
+            custom_categories
+        WHERE
+            id = ?
+        AND
+            user_id = ?`
+
+	var dto customCategoryDto
+	if err := r.Driver.Executor(ctx).GetContext(ctx, &dto, query, customCategoryID, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.NewNotFoundError(apperror.ReasonCustomCategoryNotFound, "custom category not found: %d", customCategoryID)
+		}
+
+		return nil, apperror.NewInternalError(err)
+	}
+
+	return categorydomain.NewCustomCategory(vo.CustomCategoryID(dto.ID), vo.CategoryName(dto.Name), vo.BigCategoryID(dto.BigCategoryID), vo.UserID(dto.UserID)), nil
+}
+
+func (r *categoryRepository) CreateCustomCategory(ctx context.Context, customCategory *categorydomain.CustomCategory) (vo.CustomCategoryID, error) {
+	query := `
+        INSERT INTO custom_categories
+            (category_name, big_category_id, user_id)
+        VALUES
+            (?,?,?)`
+
+	result, err := r.Driver.Executor(ctx).ExecContext(ctx, query, customCategory.Name(), customCategory.BigCategoryID(), customCategory.UserID())
+	if err != nil {
+		if rdb.IsDuplicateEntryError(err) {
+			return 0, apperror.NewConflictError(apperror.ReasonCategoryNameAlreadyExists, "custom category already exists: %s", customCategory.Name())
+		}
+
+		return 0, apperror.NewInternalError(err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, apperror.NewInternalError(err)
+	}
+
+	return vo.CustomCategoryID(id), nil
+}
+
+func (r *categoryRepository) EditCustomCategory(ctx context.Context, customCategory *categorydomain.CustomCategory) error {
+	query := `
+        UPDATE
+            custom_categories
+        SET
+            category_name = ?
+        WHERE
+            id = ?
+        AND
+            user_id = ?`
+
+	if _, err := r.Driver.Executor(ctx).ExecContext(ctx, query, customCategory.Name(), customCategory.ID(), customCategory.UserID()); err != nil {
+		if rdb.IsDuplicateEntryError(err) {
+			return apperror.NewConflictError(apperror.ReasonCategoryNameAlreadyExists, "custom category already exists: %s", customCategory.Name())
+		}
+
+		return apperror.NewInternalError(err)
+	}
+
+	return nil
+}
+
+func (r *categoryRepository) DeleteCustomCategory(ctx context.Context, userID vo.UserID, customCategoryID vo.CustomCategoryID) error {
+	query := `
+        DELETE FROM
+            custom_categories
+        WHERE
+            id = ?
+        AND
+            user_id = ?`
+
+	result, err := r.Driver.Executor(ctx).ExecContext(ctx, query, customCategoryID, userID)
+	if err != nil {
+		return apperror.NewInternalError(err)
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return apperror.NewInternalError(err)
+	}
+
+	if n == 0 {
+		return apperror.NewNotFoundError(apperror.ReasonCustomCategoryNotFound, "custom category not found: %d", customCategoryID)
+	}
+
+	return nil
+}
@@ -0,0 +1,297 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/paypay3/tukecholl-api/account/apperror"
+	"github.com/paypay3/tukecholl-api/account/domain/groupdomain"
+	"github.com/paypay3/tukecholl-api/account/domain/vo"
+	"github.com/paypay3/tukecholl-api/account/infrastructure/persistence/rdb"
+)
+
+type groupDto struct {
+	ID   int    `db:"id"`
+	Name string `db:"group_name"`
+}
+
+type memberDto struct {
+	UserID   string `db:"user_id"`
+	UserName string `db:"user_name"`
+}
+
+type groupRepository struct {
+	*rdb.Driver
+}
+
+func NewGroupRepository(rdbDriver *rdb.Driver) *groupRepository {
+	return &groupRepository{rdbDriver}
+}
+
+func (r *groupRepository) CreateGroup(ctx context.Context, name vo.GroupName) (int, error) {
+	query := `
+        INSERT INTO group_names
+            (group_name)
+        VALUES
+            (?)`
+
+	result, err := r.Driver.Executor(ctx).ExecContext(ctx, query, name)
+	if err != nil {
+		return 0, apperror.NewInternalError(err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, apperror.NewInternalError(err)
+	}
+
+	return int(id), nil
+}
+
+func (r *groupRepository) GetGroup(ctx context.Context, groupID int) (*groupdomain.Group, error) {
+	query := `
+        SELECT
+            id,
+            group_name
+        FROM
+            group_names
+        WHERE
+            id = ?`
+
+	var dto groupDto
+	if err := r.Driver.Executor(ctx).GetContext(ctx, &dto, query, groupID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.NewNotFoundError(apperror.ReasonGroupNotFound, "group not found: %d", groupID)
+		}
+
+		return nil, apperror.NewInternalError(err)
+	}
+
+	return groupdomain.NewGroup(dto.ID, vo.GroupName(dto.Name)), nil
+}
+
+func (r *groupRepository) LockGroup(ctx context.Context, groupID int) error {
+	query := `
+        SELECT
+            id
+        FROM
+            group_names
+        WHERE
+            id = ?
+        FOR UPDATE`
+
+	var id int
+	if err := r.Driver.Executor(ctx).GetContext(ctx, &id, query, groupID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperror.NewNotFoundError(apperror.ReasonGroupNotFound, "group not found: %d", groupID)
+		}
+
+		return apperror.NewInternalError(err)
+	}
+
+	return nil
+}
+
+func (r *groupRepository) GetApprovedGroups(ctx context.Context, userID vo.UserID) ([]*groupdomain.Group, error) {
+	query := `
+        SELECT
+            group_names.id,
+            group_names.group_name
+        FROM
+            group_names
+        INNER JOIN
+            group_users
+        ON
+            group_names.id = group_users.group_id
+        WHERE
+            group_users.user_id = ?
+        ORDER BY
+            group_names.id`
+
+	return r.getGroups(ctx, query, userID)
+}
+
+func (r *groupRepository) GetUnapprovedGroups(ctx context.Context, userID vo.UserID) ([]*groupdomain.Group, error) {
+	query := `
+        SELECT
+            group_names.id,
+            group_names.group_name
+        FROM
+            group_names
+        INNER JOIN
+            group_unapproved_users
+        ON
+            group_names.id = group_unapproved_users.group_id
+        WHERE
+            group_unapproved_users.user_id = ?
+        ORDER BY
+            group_names.id`
+
+	return r.getGroups(ctx, query, userID)
+}
+
+func (r *groupRepository) getGroups(ctx context.Context, query string, args ...interface{}) ([]*groupdomain.Group, error) {
+	var groupsDto []groupDto
+	if err := r.Driver.Executor(ctx).SelectContext(ctx, &groupsDto, query, args...); err != nil {
+		return nil, apperror.NewInternalError(err)
+	}
+
+	groups := make([]*groupdomain.Group, len(groupsDto))
+	for i, dto := range groupsDto {
+		groups[i] = groupdomain.NewGroup(dto.ID, vo.GroupName(dto.Name))
+	}
+
+	return groups, nil
+}
+
+func (r *groupRepository) CreateApprovedUser(ctx context.Context, groupID int, userID vo.UserID) error {
+	query := `
+        INSERT INTO group_users
+            (group_id, user_id)
+        VALUES
+            (?,?)`
+
+	if _, err := r.Driver.Executor(ctx).ExecContext(ctx, query, groupID, userID); err != nil {
+		if rdb.IsDuplicateEntryError(err) {
+			return apperror.NewConflictError(apperror.ReasonGroupUserAlreadyExists, "user already belongs to the group: %d %s", groupID, userID)
+		}
+
+		return apperror.NewInternalError(err)
+	}
+
+	return nil
+}
+
+func (r *groupRepository) DeleteApprovedUser(ctx context.Context, groupID int, userID vo.UserID) error {
+	query := `
+        DELETE FROM
+            group_users
+        WHERE
+            group_id = ?
+        AND
+            user_id = ?`
+
+	return r.deleteGroupUser(ctx, query, groupID, userID)
+}
+
+func (r *groupRepository) CreateUnapprovedUser(ctx context.Context, groupID int, userID vo.UserID) error {
+	query := `
+        INSERT INTO group_unapproved_users
+            (group_id, user_id)
+        VALUES
+            (?,?)`
+
+	if _, err := r.Driver.Executor(ctx).ExecContext(ctx, query, groupID, userID); err != nil {
+		if rdb.IsDuplicateEntryError(err) {
+			return apperror.NewConflictError(apperror.ReasonGroupInvitationAlreadyExists, "user is already invited to the group: %d %s", groupID, userID)
+		}
+
+		return apperror.NewInternalError(err)
+	}
+
+	return nil
+}
+
+func (r *groupRepository) DeleteUnapprovedUser(ctx context.Context, groupID int, userID vo.UserID) error {
+	query := `
+        DELETE FROM
+            group_unapproved_users
+        WHERE
+            group_id = ?
+        AND
+            user_id = ?`
+
+	return r.deleteGroupUser(ctx, query, groupID, userID)
+}
+
+func (r *groupRepository) deleteGroupUser(ctx context.Context, query string, groupID int, userID vo.UserID) error {
+	result, err := r.Driver.Executor(ctx).ExecContext(ctx, query, groupID, userID)
+	if err != nil {
+		return apperror.NewInternalError(err)
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return apperror.NewInternalError(err)
+	}
+
+	if n == 0 {
+		return apperror.NewNotFoundError(apperror.ReasonGroupUserNotFound, "group user not found: %d %s", groupID, userID)
+	}
+
+	return nil
+}
+
+func (r *groupRepository) IsApprovedUser(ctx context.Context, groupID int, userID vo.UserID) (bool, error) {
+	query := `
+        SELECT EXISTS (
+            SELECT
+                1
+            FROM
+                group_users
+            WHERE
+                group_id = ?
+            AND
+                user_id = ?
+        )`
+
+	var exists bool
+	if err := r.Driver.Executor(ctx).GetContext(ctx, &exists, query, groupID, userID); err != nil {
+		return false, apperror.NewInternalError(err)
+	}
+
+	return exists, nil
+}
+
+func (r *groupRepository) GetApprovedMembers(ctx context.Context, groupID int) ([]*groupdomain.Member, error) {
+	query := `
+        SELECT
+            group_users.user_id,
+            users.name user_name
+        FROM
+            group_users
+        INNER JOIN
+            users
+        ON
+            group_users.user_id = users.id
+        WHERE
+            group_users.group_id = ?
+        ORDER BY
+            group_users.user_id`
+
+	return r.getMembers(ctx, query, groupID)
+}
+
+func (r *groupRepository) GetUnapprovedMembers(ctx context.Context, groupID int) ([]*groupdomain.Member, error) {
+	query := `
+        SELECT
+            group_unapproved_users.user_id,
+            users.name user_name
+        FROM
+            group_unapproved_users
+        INNER JOIN
+            users
+        ON
+            group_unapproved_users.user_id = users.id
+        WHERE
+            group_unapproved_users.group_id = ?
+        ORDER BY
+            group_unapproved_users.user_id`
+
+	return r.getMembers(ctx, query, groupID)
+}
+
+func (r *groupRepository) getMembers(ctx context.Context, query string, groupID int) ([]*groupdomain.Member, error) {
+	var membersDto []memberDto
+	if err := r.Driver.Executor(ctx).SelectContext(ctx, &membersDto, query, groupID); err != nil {
+		return nil, apperror.NewInternalError(err)
+	}
+
+	members := make([]*groupdomain.Member, len(membersDto))
+	for i, dto := range membersDto {
+		members[i] = groupdomain.NewMember(vo.UserID(dto.UserID), vo.UserName(dto.UserName))
+	}
+
+	return members, nil
+}
@@ -0,0 +1,270 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/paypay3/tukecholl-api/account/apperror"
+	"github.com/paypay3/tukecholl-api/account/domain/transactiondomain"
+	"github.com/paypay3/tukecholl-api/account/domain/vo"
+)
+
+type groupTransactionDto struct {
+	ID                int            `db:"id"`
+	TransactionTypeID int            `db:"transaction_type_id"`
+	PostedDate        string         `db:"posted_date"`
+	UpdatedDate       string         `db:"updated_date"`
+	TransactionDate   string         `db:"transaction_date"`
+	Shop              sql.NullString `db:"shop"`
+	Memo              sql.NullString `db:"memo"`
+	Amount            int            `db:"amount"`
+	GroupID           int            `db:"group_id"`
+	PostedUserID      string         `db:"posted_user_id"`
+	UpdatedUserID     sql.NullString `db:"updated_user_id"`
+	PaymentUserID     string         `db:"payment_user_id"`
+	BigCategoryID     int            `db:"big_category_id"`
+	MediumCategoryID  sql.NullInt64  `db:"medium_category_id"`
+}
+
+type paymentTotalDto struct {
+	PaymentUserID string `db:"payment_user_id"`
+	TotalAmount   int    `db:"total_amount"`
+}
+
+// selectGroupTransactionsQuery formats the dates in SQL so that scanning does not depend on the parseTime option of the DSN.
+const selectGroupTransactionsQuery = `
+        SELECT
+            id,
+            transaction_type_id,
+            DATE_FORMAT(posted_date, '%Y-%m-%d %H:%i:%s') posted_date,
+            DATE_FORMAT(updated_date, '%Y-%m-%d %H:%i:%s') updated_date,
+            DATE_FORMAT(transaction_date, '%Y-%m-%d') transaction_date,
+            shop,
+            memo,
+            amount,
+            group_id,
+            posted_user_id,
+            updated_user_id,
+            payment_user_id,
+            big_category_id,
+            medium_category_id
+        FROM
+            group_transactions`
+
+func (r *transactionRepository) CreateGroupTransaction(ctx context.Context, transaction *transactiondomain.GroupTransaction) (int, error) {
+	query := `
+        INSERT INTO group_transactions
+            (transaction_type_id, transaction_date, shop, memo, amount, group_id, posted_user_id, payment_user_id, big_category_id, medium_category_id)
+        VALUES
+            (?,?,?,?,?,?,?,?,?,?)`
+
+	result, err := r.Driver.Executor(ctx).ExecContext(ctx, query,
+		transaction.TransactionType(),
+		transaction.TransactionDate().Format(dateLayout),
+		toNullString(transaction.Shop().Value()),
+		toNullString(transaction.Memo().Value()),
+		transaction.Amount(),
+		transaction.GroupID(),
+		transaction.PostedUserID(),
+		transaction.PaymentUserID(),
+		transaction.BigCategoryID(),
+		toNullInt64(transaction.MediumCategoryID().Value()),
+	)
+	if err != nil {
+		return 0, apperror.NewInternalError(err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, apperror.NewInternalError(err)
+	}
+
+	return int(id), nil
+}
+
+func (r *transactionRepository) GetGroupTransaction(ctx context.Context, groupID int, transactionID int) (*transactiondomain.GroupTransaction, error) {
+	query := selectGroupTransactionsQuery + `
+        WHERE
+            id = ?
+        AND
+            group_id = ?`
+
+	var dto groupTransactionDto
+	if err := r.Driver.Executor(ctx).GetContext(ctx, &dto, query, transactionID, groupID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.NewNotFoundError(apperror.ReasonGroupTransactionNotFound, "group transaction not found: %d", transactionID)
+		}
+
+		return nil, apperror.NewInternalError(err)
+	}
+
+	transaction, err := toGroupTransaction(dto)
+	if err != nil {
+		return nil, apperror.NewInternalError(err)
+	}
+
+	return transaction, nil
+}
+
+func (r *transactionRepository) GetMonthlyGroupTransactions(ctx context.Context, groupID int, yearMonth vo.YearMonth) ([]*transactiondomain.GroupTransaction, error) {
+	query := selectGroupTransactionsQuery + `
+        WHERE
+            group_id = ?
+        AND
+            transaction_date BETWEEN ? AND LAST_DAY(?)
+        ORDER BY
+            transaction_date DESC, id DESC`
+
+	var transactionsDto []groupTransactionDto
+	if err := r.Driver.Executor(ctx).SelectContext(ctx, &transactionsDto, query, groupID, yearMonth.Value(), yearMonth.Value()); err != nil {
+		return nil, apperror.NewInternalError(err)
+	}
+
+	transactions := make([]*transactiondomain.GroupTransaction, len(transactionsDto))
+	for i, dto := range transactionsDto {
+		transaction, err := toGroupTransaction(dto)
+		if err != nil {
+			return nil, apperror.NewInternalError(err)
+		}
+
+		transactions[i] = transaction
+	}
+
+	return transactions, nil
+}
+
+func (r *transactionRepository) GetMonthlyPaymentTotals(ctx context.Context, groupID int, yearMonth vo.YearMonth, transactionType vo.TransactionType) ([]*transactiondomain.PaymentTotal, error) {
+	query := `
+        SELECT
+            payment_user_id,
+            SUM(amount) total_amount
+        FROM
+            group_transactions
+        WHERE
+            group_id = ?
+        AND
+            transaction_type_id = ?
+        AND
+            transaction_date BETWEEN ? AND LAST_DAY(?)
+        GROUP BY
+            payment_user_id
+        ORDER BY
+            payment_user_id`
+
+	var paymentTotalsDto []paymentTotalDto
+	if err := r.Driver.Executor(ctx).SelectContext(ctx, &paymentTotalsDto, query, groupID, transactionType, yearMonth.Value(), yearMonth.Value()); err != nil {
+		return nil, apperror.NewInternalError(err)
+	}
+
+	paymentTotals := make([]*transactiondomain.PaymentTotal, len(paymentTotalsDto))
+	for i, dto := range paymentTotalsDto {
+		paymentTotals[i] = transactiondomain.NewPaymentTotal(vo.UserID(dto.PaymentUserID), dto.TotalAmount)
+	}
+
+	return paymentTotals, nil
+}
+
+func (r *transactionRepository) EditGroupTransaction(ctx context.Context, transaction *transactiondomain.GroupTransaction) error {
+	query := `
+        UPDATE
+            group_transactions
+        SET
+            transaction_type_id = ?,
+            transaction_date = ?,
+            shop = ?,
+            memo = ?,
+            amount = ?,
+            updated_user_id = ?,
+            payment_user_id = ?,
+            big_category_id = ?,
+            medium_category_id = ?
+        WHERE
+            id = ?
+        AND
+            group_id = ?`
+
+	if _, err := r.Driver.Executor(ctx).ExecContext(ctx, query,
+		transaction.TransactionType(),
+		transaction.TransactionDate().Format(dateLayout),
+		toNullString(transaction.Shop().Value()),
+		toNullString(transaction.Memo().Value()),
+		transaction.Amount(),
+		toNullString(transaction.UpdatedUserID().Value()),
+		transaction.PaymentUserID(),
+		transaction.BigCategoryID(),
+		toNullInt64(transaction.MediumCategoryID().Value()),
+		transaction.ID(),
+		transaction.GroupID(),
+	); err != nil {
+		return apperror.NewInternalError(err)
+	}
+
+	return nil
+}
+
+func (r *transactionRepository) DeleteGroupTransaction(ctx context.Context, groupID int, transactionID int) error {
+	query := `
+        DELETE FROM
+            group_transactions
+        WHERE
+            id = ?
+        AND
+            group_id = ?`
+
+	result, err := r.Driver.Executor(ctx).ExecContext(ctx, query, transactionID, groupID)
+	if err != nil {
+		return apperror.NewInternalError(err)
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return apperror.NewInternalError(err)
+	}
+
+	if n == 0 {
+		return apperror.NewNotFoundError(apperror.ReasonGroupTransactionNotFound, "group transaction not found: %d", transactionID)
+	}
+
+	return nil
+}
+
+func toGroupTransaction(dto groupTransactionDto) (*transactiondomain.GroupTransaction, error) {
+	transactionType, err := vo.NewTransactionType(dto.TransactionTypeID)
+	if err != nil {
+		return nil, err
+	}
+
+	postedDate, err := time.Parse(datetimeLayout, dto.PostedDate)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedDate, err := time.Parse(datetimeLayout, dto.UpdatedDate)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionDate, err := time.Parse(dateLayout, dto.TransactionDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return transactiondomain.NewGroupTransaction(
+		dto.ID,
+		transactionType,
+		postedDate,
+		updatedDate,
+		transactionDate,
+		vo.Shop(dto.Shop.String),
+		vo.Memo(dto.Memo.String),
+		vo.Amount(dto.Amount),
+		dto.GroupID,
+		vo.UserID(dto.PostedUserID),
+		vo.UserID(dto.UpdatedUserID.String),
+		vo.UserID(dto.PaymentUserID),
+		vo.BigCategoryID(dto.BigCategoryID),
+		vo.MediumCategoryID(dto.MediumCategoryID.Int64),
+	), nil
+}
@@ -0,0 +1,16 @@
+package rdb
+
+import (
+	"errors"
+
+	"github.com/go-sql-driver/mysql"
+)
+
+const errNumDuplicateEntry = 1062
+
+// IsDuplicateEntryError reports whether err is a MySQL duplicate-key error (1062).
+func IsDuplicateEntryError(err error) bool {
+	var mysqlErr *mysql.MySQLError
+
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == errNumDuplicateEntry
+}
@@ -1,6 +1,8 @@
 package rdb
 
 import (
+	"time"
+
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/jmoiron/sqlx"
 
@@ -9,6 +11,8 @@ import (
 
 type Driver struct {
 	Conn *sqlx.DB
+
+	slowQueryThreshold time.Duration
 }
 
 func NewDriver() (*Driver, error) {
@@ -26,6 +30,7 @@ func NewDriver() (*Driver, error) {
 	conn.SetConnMaxLifetime(config.Env.RDB.MaxConnLifetime)
 
 	return &Driver{
-		Conn: conn,
+		Conn:               conn,
+		slowQueryThreshold: config.Env.RDB.SlowQueryThreshold,
 	}, nil
 }
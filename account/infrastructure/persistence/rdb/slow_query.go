@@ -0,0 +1,70 @@
+package rdb
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/paypay3/tukecholl-api/account/logger"
+)
+
+// slowQueryExecutor logs the queries which take longer than the threshold with the logger of the context,
+// so that the logs carry the request the queries belong to.
+// the arguments of the queries are not logged because they may contain personal information.
+type slowQueryExecutor struct {
+	Executor
+	threshold time.Duration
+}
+
+func (e *slowQueryExecutor) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	defer e.logIfSlow(ctx, query, time.Now())
+
+	return e.Executor.ExecContext(ctx, query, args...)
+}
+
+func (e *slowQueryExecutor) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	defer e.logIfSlow(ctx, query, time.Now())
+
+	return e.Executor.QueryContext(ctx, query, args...)
+}
+
+func (e *slowQueryExecutor) QueryxContext(ctx context.Context, query string, args ...interface{}) (*sqlx.Rows, error) {
+	defer e.logIfSlow(ctx, query, time.Now())
+
+	return e.Executor.QueryxContext(ctx, query, args...)
+}
+
+func (e *slowQueryExecutor) QueryRowxContext(ctx context.Context, query string, args ...interface{}) *sqlx.Row {
+	defer e.logIfSlow(ctx, query, time.Now())
+
+	return e.Executor.QueryRowxContext(ctx, query, args...)
+}
+
+func (e *slowQueryExecutor) GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error {
+	defer e.logIfSlow(ctx, query, time.Now())
+
+	return e.Executor.GetContext(ctx, dest, query, args...)
+}
+
+func (e *slowQueryExecutor) SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error {
+	defer e.logIfSlow(ctx, query, time.Now())
+
+	return e.Executor.SelectContext(ctx, dest, query, args...)
+}
+
+func (e *slowQueryExecutor) logIfSlow(ctx context.Context, query string, start time.Time) {
+	elapsed := time.Since(start)
+	if elapsed < e.threshold {
+		return
+	}
+
+	// the queries are written over several lines in the repositories.
+	logger.FromContext(ctx).Warn("slow query",
+		"query", strings.Join(strings.Fields(query), " "),
+		"duration_ms", float64(elapsed.Microseconds())/1000,
+		"threshold_ms", float64(e.threshold.Microseconds())/1000,
+	)
+}
@@ -0,0 +1,68 @@
+package rdb
+
+import (
+	"context"
+
+	"github.com/jmoiron/sqlx"
+
+	"github.com/paypay3/tukecholl-api/account/apperror"
+)
+
+type txKey struct{}
+
+// Executor is implemented by both *sqlx.DB and *sqlx.Tx.
+type Executor interface {
+	sqlx.ExtContext
+	GetContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+	SelectContext(ctx context.Context, dest interface{}, query string, args ...interface{}) error
+}
+
+// Transaction runs fn in a transaction, which is committed if fn returns nil and rolled back otherwise.
+// Repositories called with the ctx passed to fn take part in the transaction.
+// If ctx already carries a transaction, fn joins it instead of starting a new one.
+func (d *Driver) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(*sqlx.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := d.Conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return apperror.NewInternalError(err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return apperror.NewInternalError(err)
+	}
+
+	return nil
+}
+
+// Executor returns the transaction carried by ctx, or the connection pool if there is none.
+// the queries are logged if they are slower than the slow query threshold.
+func (d *Driver) Executor(ctx context.Context) Executor {
+	var executor Executor = d.Conn
+	if tx, ok := ctx.Value(txKey{}).(*sqlx.Tx); ok {
+		executor = tx
+	}
+
+	if d.slowQueryThreshold <= 0 {
+		return executor
+	}
+
+	return &slowQueryExecutor{
+		Executor:  executor,
+		threshold: d.slowQueryThreshold,
+	}
+}
@@ -0,0 +1,339 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"github.com/paypay3/tukecholl-api/account/apperror"
+	"github.com/paypay3/tukecholl-api/account/domain/recurringdomain"
+	"github.com/paypay3/tukecholl-api/account/domain/vo"
+	"github.com/paypay3/tukecholl-api/account/infrastructure/persistence/rdb"
+)
+
+type recurringTransactionDto struct {
+	ID                int            `db:"id"`
+	TransactionTypeID int            `db:"transaction_type_id"`
+	Shop              sql.NullString `db:"shop"`
+	Memo              sql.NullString `db:"memo"`
+	Amount            int            `db:"amount"`
+	UserID            string         `db:"user_id"`
+	BigCategoryID     int            `db:"big_category_id"`
+	MediumCategoryID  sql.NullInt64  `db:"medium_category_id"`
+	CustomCategoryID  sql.NullInt64  `db:"custom_category_id"`
+	RecurrenceTypeID  int            `db:"recurrence_type_id"`
+	DayOfMonth        sql.NullInt64  `db:"day_of_month"`
+	DayOfWeek         sql.NullInt64  `db:"day_of_week"`
+	StartDate         string         `db:"start_date"`
+	EndDate           sql.NullString `db:"end_date"`
+	LastExecutionDate sql.NullString `db:"last_execution_date"`
+}
+
+type recurringTransactionRepository struct {
+	*rdb.Driver
+}
+
+func NewRecurringTransactionRepository(rdbDriver *rdb.Driver) *recurringTransactionRepository {
+	return &recurringTransactionRepository{rdbDriver}
+}
+
+// selectRecurringTransactionsQuery formats the dates in SQL so that scanning does not depend on the parseTime option of the DSN.
+// the rows are grouped by the primary key, so the other columns of recurring_transactions can be selected.
+const selectRecurringTransactionsQuery = `
+        SELECT
+            recurring_transactions.id,
+            recurring_transactions.transaction_type_id,
+            recurring_transactions.shop,
+            recurring_transactions.memo,
+            recurring_transactions.amount,
+            recurring_transactions.user_id,
+            recurring_transactions.big_category_id,
+            recurring_transactions.medium_category_id,
+            recurring_transactions.custom_category_id,
+            recurring_transactions.recurrence_type_id,
+            recurring_transactions.day_of_month,
+            recurring_transactions.day_of_week,
+            DATE_FORMAT(recurring_transactions.start_date, '%Y-%m-%d') start_date,
+            DATE_FORMAT(recurring_transactions.end_date, '%Y-%m-%d') end_date,
+            DATE_FORMAT(MAX(recurring_transaction_executions.execution_date), '%Y-%m-%d') last_execution_date
+        FROM
+            recurring_transactions
+        LEFT JOIN
+            recurring_transaction_executions
+        ON
+            recurring_transactions.id = recurring_transaction_executions.recurring_transaction_id`
+
+func (r *recurringTransactionRepository) CreateRecurringTransaction(ctx context.Context, recurringTransaction *recurringdomain.RecurringTransaction) (int, error) {
+	query := `
+        INSERT INTO recurring_transactions
+            (transaction_type_id, shop, memo, amount, user_id, big_category_id, medium_category_id, custom_category_id, recurrence_type_id, day_of_month, day_of_week, start_date, end_date)
+        VALUES
+            (?,?,?,?,?,?,?,?,?,?,?,?,?)`
+
+	args := append([]interface{}{
+		recurringTransaction.TransactionType(),
+		toNullString(recurringTransaction.Shop().Value()),
+		toNullString(recurringTransaction.Memo().Value()),
+		recurringTransaction.Amount(),
+		recurringTransaction.UserID(),
+		recurringTransaction.BigCategoryID(),
+		toNullInt64(recurringTransaction.MediumCategoryID().Value()),
+		toNullInt64(recurringTransaction.CustomCategoryID().Value()),
+	}, toRecurrenceArgs(recurringTransaction)...)
+
+	result, err := r.Driver.Executor(ctx).ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, apperror.NewInternalError(err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, apperror.NewInternalError(err)
+	}
+
+	return int(id), nil
+}
+
+func (r *recurringTransactionRepository) GetRecurringTransaction(ctx context.Context, userID vo.UserID, recurringTransactionID int) (*recurringdomain.RecurringTransaction, error) {
+	query := selectRecurringTransactionsQuery + `
+        WHERE
+            recurring_transactions.id = ?
+        AND
+            recurring_transactions.user_id = ?
+        GROUP BY
+            recurring_transactions.id`
+
+	var dto recurringTransactionDto
+	if err := r.Driver.Executor(ctx).GetContext(ctx, &dto, query, recurringTransactionID, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.NewNotFoundError(apperror.ReasonRecurringTransactionNotFound, "recurring transaction not found: %d", recurringTransactionID)
+		}
+
+		return nil, apperror.NewInternalError(err)
+	}
+
+	recurringTransaction, err := toRecurringTransaction(dto)
+	if err != nil {
+		return nil, apperror.NewInternalError(err)
+	}
+
+	return recurringTransaction, nil
+}
+
+func (r *recurringTransactionRepository) GetRecurringTransactions(ctx context.Context, userID vo.UserID) ([]*recurringdomain.RecurringTransaction, error) {
+	query := selectRecurringTransactionsQuery + `
+        WHERE
+            recurring_transactions.user_id = ?
+        GROUP BY
+            recurring_transactions.id
+        ORDER BY
+            recurring_transactions.id`
+
+	return r.getRecurringTransactions(ctx, query, userID)
+}
+
+func (r *recurringTransactionRepository) GetActiveRecurringTransactions(ctx context.Context, date time.Time) ([]*recurringdomain.RecurringTransaction, error) {
+	query := selectRecurringTransactionsQuery + `
+        WHERE
+            recurring_transactions.start_date <= ?
+        GROUP BY
+            recurring_transactions.id
+        HAVING
+            end_date IS NULL
+        OR
+            last_execution_date IS NULL
+        OR
+            last_execution_date < end_date
+        ORDER BY
+            recurring_transactions.id`
+
+	return r.getRecurringTransactions(ctx, query, date.Format(dateLayout))
+}
+
+func (r *recurringTransactionRepository) getRecurringTransactions(ctx context.Context, query string, args ...interface{}) ([]*recurringdomain.RecurringTransaction, error) {
+	var recurringTransactionsDto []recurringTransactionDto
+	if err := r.Driver.Executor(ctx).SelectContext(ctx, &recurringTransactionsDto, query, args...); err != nil {
+		return nil, apperror.NewInternalError(err)
+	}
+
+	recurringTransactions := make([]*recurringdomain.RecurringTransaction, len(recurringTransactionsDto))
+	for i, dto := range recurringTransactionsDto {
+		recurringTransaction, err := toRecurringTransaction(dto)
+		if err != nil {
+			return nil, apperror.NewInternalError(err)
+		}
+
+		recurringTransactions[i] = recurringTransaction
+	}
+
+	return recurringTransactions, nil
+}
+
+func (r *recurringTransactionRepository) EditRecurringTransaction(ctx context.Context, recurringTransaction *recurringdomain.RecurringTransaction) error {
+	query := `
+        UPDATE
+            recurring_transactions
+        SET
+            transaction_type_id = ?,
+            shop = ?,
+            memo = ?,
+            amount = ?,
+            big_category_id = ?,
+            medium_category_id = ?,
+            custom_category_id = ?,
+            recurrence_type_id = ?,
+            day_of_month = ?,
+            day_of_week = ?,
+            start_date = ?,
+            end_date = ?
+        WHERE
+            id = ?
+        AND
+            user_id = ?`
+
+	args := append([]interface{}{
+		recurringTransaction.TransactionType(),
+		toNullString(recurringTransaction.Shop().Value()),
+		toNullString(recurringTransaction.Memo().Value()),
+		recurringTransaction.Amount(),
+		recurringTransaction.BigCategoryID(),
+		toNullInt64(recurringTransaction.MediumCategoryID().Value()),
+		toNullInt64(recurringTransaction.CustomCategoryID().Value()),
+	}, toRecurrenceArgs(recurringTransaction)...)
+	args = append(args, recurringTransaction.ID(), recurringTransaction.UserID())
+
+	if _, err := r.Driver.Executor(ctx).ExecContext(ctx, query, args...); err != nil {
+		return apperror.NewInternalError(err)
+	}
+
+	return nil
+}
+
+func (r *recurringTransactionRepository) DeleteRecurringTransaction(ctx context.Context, userID vo.UserID, recurringTransactionID int) error {
+	query := `
+        DELETE FROM
+            recurring_transactions
+        WHERE
+            id = ?
+        AND
+            user_id = ?`
+
+	result, err := r.Driver.Executor(ctx).ExecContext(ctx, query, recurringTransactionID, userID)
+	if err != nil {
+		return apperror.NewInternalError(err)
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return apperror.NewInternalError(err)
+	}
+
+	if n == 0 {
+		return apperror.NewNotFoundError(apperror.ReasonRecurringTransactionNotFound, "recurring transaction not found: %d", recurringTransactionID)
+	}
+
+	return nil
+}
+
+func (r *recurringTransactionRepository) CreateExecution(ctx context.Context, recurringTransactionID int, executionDate time.Time, transactionID int) error {
+	query := `
+        INSERT INTO recurring_transaction_executions
+            (recurring_transaction_id, execution_date, transaction_id)
+        VALUES
+            (?,?,?)`
+
+	if _, err := r.Driver.Executor(ctx).ExecContext(ctx, query, recurringTransactionID, executionDate.Format(dateLayout), transactionID); err != nil {
+		if rdb.IsDuplicateEntryError(err) {
+			return apperror.NewConflictError(apperror.ReasonRecurringTransactionAlreadyExecuted, "recurring transaction already executed: %d %s", recurringTransactionID, executionDate.Format(dateLayout))
+		}
+
+		return apperror.NewInternalError(err)
+	}
+
+	return nil
+}
+
+// toRecurrenceArgs returns the arguments for recurrence_type_id, day_of_month, day_of_week, start_date and end_date.
+func toRecurrenceArgs(recurringTransaction *recurringdomain.RecurringTransaction) []interface{} {
+	recurrenceRule := recurringTransaction.RecurrenceRule()
+
+	dayOfMonth := sql.NullInt64{}
+	dayOfWeek := sql.NullInt64{}
+	switch recurrenceRule.RecurrenceType() {
+	case recurringdomain.RecurrenceTypeMonthly:
+		dayOfMonth = sql.NullInt64{Int64: int64(recurrenceRule.DayOfMonth()), Valid: true}
+	case recurringdomain.RecurrenceTypeWeekly:
+		dayOfWeek = sql.NullInt64{Int64: int64(recurrenceRule.DayOfWeek()), Valid: true}
+	}
+
+	endDate := sql.NullString{}
+	if !recurringTransaction.EndDate().IsZero() {
+		endDate = sql.NullString{String: recurringTransaction.EndDate().Format(dateLayout), Valid: true}
+	}
+
+	return []interface{}{
+		recurrenceRule.RecurrenceType(),
+		dayOfMonth,
+		dayOfWeek,
+		recurringTransaction.StartDate().Format(dateLayout),
+		endDate,
+	}
+}
+
+func toRecurringTransaction(dto recurringTransactionDto) (*recurringdomain.RecurringTransaction, error) {
+	transactionType, err := vo.NewTransactionType(dto.TransactionTypeID)
+	if err != nil {
+		return nil, err
+	}
+
+	var recurrenceRule *recurringdomain.RecurrenceRule
+	switch recurringdomain.RecurrenceType(dto.RecurrenceTypeID) {
+	case recurringdomain.RecurrenceTypeMonthly:
+		recurrenceRule, err = recurringdomain.NewMonthlyRecurrenceRule(int(dto.DayOfMonth.Int64))
+	case recurringdomain.RecurrenceTypeWeekly:
+		recurrenceRule, err = recurringdomain.NewWeeklyRecurrenceRule(int(dto.DayOfWeek.Int64))
+	default:
+		err = xerrors.Errorf("unknown recurrence type: %d", dto.RecurrenceTypeID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	startDate, err := time.Parse(dateLayout, dto.StartDate)
+	if err != nil {
+		return nil, err
+	}
+
+	var endDate time.Time
+	if dto.EndDate.Valid {
+		if endDate, err = time.Parse(dateLayout, dto.EndDate.String); err != nil {
+			return nil, err
+		}
+	}
+
+	var lastExecutionDate time.Time
+	if dto.LastExecutionDate.Valid {
+		if lastExecutionDate, err = time.Parse(dateLayout, dto.LastExecutionDate.String); err != nil {
+			return nil, err
+		}
+	}
+
+	return recurringdomain.NewRecurringTransaction(
+		dto.ID,
+		transactionType,
+		vo.Shop(dto.Shop.String),
+		vo.Memo(dto.Memo.String),
+		vo.Amount(dto.Amount),
+		vo.UserID(dto.UserID),
+		vo.BigCategoryID(dto.BigCategoryID),
+		vo.MediumCategoryID(dto.MediumCategoryID.Int64),
+		vo.CustomCategoryID(dto.CustomCategoryID.Int64),
+		recurrenceRule,
+		startDate,
+		endDate,
+		lastExecutionDate,
+	), nil
+}
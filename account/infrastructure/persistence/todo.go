@@ -0,0 +1,470 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/paypay3/tukecholl-api/account/apperror"
+	"github.com/paypay3/tukecholl-api/account/domain/tododomain"
+	"github.com/paypay3/tukecholl-api/account/domain/vo"
+	"github.com/paypay3/tukecholl-api/account/infrastructure/persistence/rdb"
+)
+
+type todoDto struct {
+	ID                 int            `db:"id"`
+	PostedDate         string         `db:"posted_date"`
+	UpdatedDate        string         `db:"updated_date"`
+	ImplementationDate string         `db:"implementation_date"`
+	DueDate            string         `db:"due_date"`
+	Content            string         `db:"todo_content"`
+	Completed          bool           `db:"completed"`
+	GroupID            int            `db:"group_id"`
+	PostedUserID       string         `db:"posted_user_id"`
+	AssigneeUserID     sql.NullString `db:"assignee_user_id"`
+}
+
+type shoppingItemDto struct {
+	ID                   int            `db:"id"`
+	PostedDate           string         `db:"posted_date"`
+	UpdatedDate          string         `db:"updated_date"`
+	ExpectedPurchaseDate string         `db:"expected_purchase_date"`
+	Name                 string         `db:"item_name"`
+	Shop                 sql.NullString `db:"shop"`
+	Amount               sql.NullInt64  `db:"amount"`
+	Completed            bool           `db:"completed"`
+	GroupID              int            `db:"group_id"`
+	PostedUserID         string         `db:"posted_user_id"`
+	AssigneeUserID       sql.NullString `db:"assignee_user_id"`
+	BigCategoryID        int            `db:"big_category_id"`
+	MediumCategoryID     sql.NullInt64  `db:"medium_category_id"`
+	TransactionID        sql.NullInt64  `db:"transaction_id"`
+}
+
+type todoRepository struct {
+	*rdb.Driver
+}
+
+func NewTodoRepository(rdbDriver *rdb.Driver) *todoRepository {
+	return &todoRepository{rdbDriver}
+}
+
+// selectTodosQuery formats the dates in SQL so that scanning does not depend on the parseTime option of the DSN.
+const selectTodosQuery = `
+        SELECT
+            id,
+            DATE_FORMAT(posted_date, '%Y-%m-%d %H:%i:%s') posted_date,
+            DATE_FORMAT(updated_date, '%Y-%m-%d %H:%i:%s') updated_date,
+            DATE_FORMAT(implementation_date, '%Y-%m-%d') implementation_date,
+            DATE_FORMAT(due_date, '%Y-%m-%d') due_date,
+            todo_content,
+            completed,
+            group_id,
+            posted_user_id,
+            assignee_user_id
+        FROM
+            group_todos`
+
+// selectShoppingItemsQuery formats the dates in SQL so that scanning does not depend on the parseTime option of the DSN.
+const selectShoppingItemsQuery = `
+        SELECT
+            id,
+            DATE_FORMAT(posted_date, '%Y-%m-%d %H:%i:%s') posted_date,
+            DATE_FORMAT(updated_date, '%Y-%m-%d %H:%i:%s') updated_date,
+            DATE_FORMAT(expected_purchase_date, '%Y-%m-%d') expected_purchase_date,
+            item_name,
+            shop,
+            amount,
+            completed,
+            group_id,
+            posted_user_id,
+            assignee_user_id,
+            big_category_id,
+            medium_category_id,
+            transaction_id
+        FROM
+            group_shopping_items`
+
+func (r *todoRepository) CreateTodo(ctx context.Context, todo *tododomain.Todo) (int, error) {
+	query := `
+        INSERT INTO group_todos
+            (implementation_date, due_date, todo_content, completed, group_id, posted_user_id, assignee_user_id)
+        VALUES
+            (?,?,?,?,?,?,?)`
+
+	result, err := r.Driver.Executor(ctx).ExecContext(ctx, query,
+		todo.ImplementationDate().Format(dateLayout),
+		todo.DueDate().Format(dateLayout),
+		todo.Content(),
+		todo.Completed(),
+		todo.GroupID(),
+		todo.PostedUserID(),
+		toNullString(todo.AssigneeUserID().Value()),
+	)
+	if err != nil {
+		return 0, apperror.NewInternalError(err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, apperror.NewInternalError(err)
+	}
+
+	return int(id), nil
+}
+
+func (r *todoRepository) GetTodo(ctx context.Context, groupID int, todoID int) (*tododomain.Todo, error) {
+	query := selectTodosQuery + `
+        WHERE
+            id = ?
+        AND
+            group_id = ?`
+
+	var dto todoDto
+	if err := r.Driver.Executor(ctx).GetContext(ctx, &dto, query, todoID, groupID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.NewNotFoundError(apperror.ReasonTodoNotFound, "todo not found: %d", todoID)
+		}
+
+		return nil, apperror.NewInternalError(err)
+	}
+
+	todo, err := toTodo(dto)
+	if err != nil {
+		return nil, apperror.NewInternalError(err)
+	}
+
+	return todo, nil
+}
+
+func (r *todoRepository) GetTodos(ctx context.Context, groupID int, from, to time.Time) ([]*tododomain.Todo, error) {
+	query := selectTodosQuery + `
+        WHERE
+            group_id = ?
+        AND
+            due_date BETWEEN ? AND ?
+        ORDER BY
+            due_date, id`
+
+	var todosDto []todoDto
+	if err := r.Driver.Executor(ctx).SelectContext(ctx, &todosDto, query, groupID, from.Format(dateLayout), to.Format(dateLayout)); err != nil {
+		return nil, apperror.NewInternalError(err)
+	}
+
+	todos := make([]*tododomain.Todo, len(todosDto))
+	for i, dto := range todosDto {
+		todo, err := toTodo(dto)
+		if err != nil {
+			return nil, apperror.NewInternalError(err)
+		}
+
+		todos[i] = todo
+	}
+
+	return todos, nil
+}
+
+func (r *todoRepository) EditTodo(ctx context.Context, todo *tododomain.Todo) error {
+	query := `
+        UPDATE
+            group_todos
+        SET
+            implementation_date = ?,
+            due_date = ?,
+            todo_content = ?,
+            completed = ?,
+            assignee_user_id = ?
+        WHERE
+            id = ?
+        AND
+            group_id = ?`
+
+	if _, err := r.Driver.Executor(ctx).ExecContext(ctx, query,
+		todo.ImplementationDate().Format(dateLayout),
+		todo.DueDate().Format(dateLayout),
+		todo.Content(),
+		todo.Completed(),
+		toNullString(todo.AssigneeUserID().Value()),
+		todo.ID(),
+		todo.GroupID(),
+	); err != nil {
+		return apperror.NewInternalError(err)
+	}
+
+	return nil
+}
+
+func (r *todoRepository) DeleteTodo(ctx context.Context, groupID int, todoID int) error {
+	query := `
+        DELETE FROM
+            group_todos
+        WHERE
+            id = ?
+        AND
+            group_id = ?`
+
+	result, err := r.Driver.Executor(ctx).ExecContext(ctx, query, todoID, groupID)
+	if err != nil {
+		return apperror.NewInternalError(err)
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return apperror.NewInternalError(err)
+	}
+
+	if n == 0 {
+		return apperror.NewNotFoundError(apperror.ReasonTodoNotFound, "todo not found: %d", todoID)
+	}
+
+	return nil
+}
+
+func (r *todoRepository) CreateShoppingItem(ctx context.Context, shoppingItem *tododomain.ShoppingItem) (int, error) {
+	query := `
+        INSERT INTO group_shopping_items
+            (expected_purchase_date, item_name, shop, amount, completed, group_id, posted_user_id, assignee_user_id, big_category_id, medium_category_id, transaction_id)
+        VALUES
+            (?,?,?,?,?,?,?,?,?,?,?)`
+
+	result, err := r.Driver.Executor(ctx).ExecContext(ctx, query,
+		shoppingItem.ExpectedPurchaseDate().Format(dateLayout),
+		shoppingItem.Name(),
+		toNullString(shoppingItem.Shop().Value()),
+		toNullInt64(shoppingItem.Amount().Value()),
+		shoppingItem.Completed(),
+		shoppingItem.GroupID(),
+		shoppingItem.PostedUserID(),
+		toNullString(shoppingItem.AssigneeUserID().Value()),
+		shoppingItem.BigCategoryID(),
+		toNullInt64(shoppingItem.MediumCategoryID().Value()),
+		toNullInt64(shoppingItem.TransactionID()),
+	)
+	if err != nil {
+		return 0, apperror.NewInternalError(err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, apperror.NewInternalError(err)
+	}
+
+	return int(id), nil
+}
+
+func (r *todoRepository) GetShoppingItem(ctx context.Context, groupID int, shoppingItemID int) (*tododomain.ShoppingItem, error) {
+	query := selectShoppingItemsQuery + `
+        WHERE
+            id = ?
+        AND
+            group_id = ?`
+
+	var dto shoppingItemDto
+	if err := r.Driver.Executor(ctx).GetContext(ctx, &dto, query, shoppingItemID, groupID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.NewNotFoundError(apperror.ReasonShoppingItemNotFound, "shopping item not found: %d", shoppingItemID)
+		}
+
+		return nil, apperror.NewInternalError(err)
+	}
+
+	shoppingItem, err := toShoppingItem(dto)
+	if err != nil {
+		return nil, apperror.NewInternalError(err)
+	}
+
+	return shoppingItem, nil
+}
+
+func (r *todoRepository) GetShoppingItems(ctx context.Context, groupID int, from, to time.Time) ([]*tododomain.ShoppingItem, error) {
+	query := selectShoppingItemsQuery + `
+        WHERE
+            group_id = ?
+        AND
+            expected_purchase_date BETWEEN ? AND ?
+        ORDER BY
+            expected_purchase_date, id`
+
+	var shoppingItemsDto []shoppingItemDto
+	if err := r.Driver.Executor(ctx).SelectContext(ctx, &shoppingItemsDto, query, groupID, from.Format(dateLayout), to.Format(dateLayout)); err != nil {
+		return nil, apperror.NewInternalError(err)
+	}
+
+	shoppingItems := make([]*tododomain.ShoppingItem, len(shoppingItemsDto))
+	for i, dto := range shoppingItemsDto {
+		shoppingItem, err := toShoppingItem(dto)
+		if err != nil {
+			return nil, apperror.NewInternalError(err)
+		}
+
+		shoppingItems[i] = shoppingItem
+	}
+
+	return shoppingItems, nil
+}
+
+func (r *todoRepository) EditShoppingItem(ctx context.Context, shoppingItem *tododomain.ShoppingItem) error {
+	query := `
+        UPDATE
+            group_shopping_items
+        SET
+            expected_purchase_date = ?,
+            item_name = ?,
+            shop = ?,
+            amount = ?,
+            completed = ?,
+            assignee_user_id = ?,
+            big_category_id = ?,
+            medium_category_id = ?,
+            transaction_id = ?
+        WHERE
+            id = ?
+        AND
+            group_id = ?`
+
+	if _, err := r.Driver.Executor(ctx).ExecContext(ctx, query,
+		shoppingItem.ExpectedPurchaseDate().Format(dateLayout),
+		shoppingItem.Name(),
+		toNullString(shoppingItem.Shop().Value()),
+		toNullInt64(shoppingItem.Amount().Value()),
+		shoppingItem.Completed(),
+		toNullString(shoppingItem.AssigneeUserID().Value()),
+		shoppingItem.BigCategoryID(),
+		toNullInt64(shoppingItem.MediumCategoryID().Value()),
+		toNullInt64(shoppingItem.TransactionID()),
+		shoppingItem.ID(),
+		shoppingItem.GroupID(),
+	); err != nil {
+		return apperror.NewInternalError(err)
+	}
+
+	return nil
+}
+
+func (r *todoRepository) DeleteShoppingItem(ctx context.Context, groupID int, shoppingItemID int) error {
+	query := `
+        DELETE FROM
+            group_shopping_items
+        WHERE
+            id = ?
+        AND
+            group_id = ?`
+
+	result, err := r.Driver.Executor(ctx).ExecContext(ctx, query, shoppingItemID, groupID)
+	if err != nil {
+		return apperror.NewInternalError(err)
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return apperror.NewInternalError(err)
+	}
+
+	if n == 0 {
+		return apperror.NewNotFoundError(apperror.ReasonShoppingItemNotFound, "shopping item not found: %d", shoppingItemID)
+	}
+
+	return nil
+}
+
+func (r *todoRepository) PurchaseShoppingItem(ctx context.Context, groupID int, shoppingItemID int, transactionID int) error {
+	query := `
+        UPDATE
+            group_shopping_items
+        SET
+            completed = 1,
+            transaction_id = ?
+        WHERE
+            id = ?
+        AND
+            group_id = ?
+        AND
+            transaction_id IS NULL`
+
+	result, err := r.Driver.Executor(ctx).ExecContext(ctx, query, transactionID, shoppingItemID, groupID)
+	if err != nil {
+		return apperror.NewInternalError(err)
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return apperror.NewInternalError(err)
+	}
+
+	// the transaction id is always changed from NULL, so no rows affected means that the item has already been purchased.
+	if n == 0 {
+		return apperror.NewFailedPreconditionError(apperror.ReasonShoppingItemAlreadyPurchased, "shopping item already purchased: %d", shoppingItemID)
+	}
+
+	return nil
+}
+
+func toTodo(dto todoDto) (*tododomain.Todo, error) {
+	postedDate, err := time.Parse(datetimeLayout, dto.PostedDate)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedDate, err := time.Parse(datetimeLayout, dto.UpdatedDate)
+	if err != nil {
+		return nil, err
+	}
+
+	implementationDate, err := time.Parse(dateLayout, dto.ImplementationDate)
+	if err != nil {
+		return nil, err
+	}
+
+	dueDate, err := time.Parse(dateLayout, dto.DueDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return tododomain.NewTodo(
+		dto.ID,
+		postedDate,
+		updatedDate,
+		implementationDate,
+		dueDate,
+		vo.TodoContent(dto.Content),
+		dto.Completed,
+		dto.GroupID,
+		vo.UserID(dto.PostedUserID),
+		vo.UserID(dto.AssigneeUserID.String),
+	), nil
+}
+
+func toShoppingItem(dto shoppingItemDto) (*tododomain.ShoppingItem, error) {
+	postedDate, err := time.Parse(datetimeLayout, dto.PostedDate)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedDate, err := time.Parse(datetimeLayout, dto.UpdatedDate)
+	if err != nil {
+		return nil, err
+	}
+
+	expectedPurchaseDate, err := time.Parse(dateLayout, dto.ExpectedPurchaseDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return tododomain.NewShoppingItem(
+		dto.ID,
+		postedDate,
+		updatedDate,
+		expectedPurchaseDate,
+		vo.ShoppingItemName(dto.Name),
+		vo.Shop(dto.Shop.String),
+		vo.Amount(dto.Amount.Int64),
+		dto.Completed,
+		dto.GroupID,
+		vo.UserID(dto.PostedUserID),
+		vo.UserID(dto.AssigneeUserID.String),
+		vo.BigCategoryID(dto.BigCategoryID),
+		vo.MediumCategoryID(dto.MediumCategoryID.Int64),
+		int(dto.TransactionID.Int64),
+	), nil
+}
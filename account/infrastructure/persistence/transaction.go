@@ -0,0 +1,389 @@
+package persistence
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/paypay3/tukecholl-api/account/apperror"
+	"github.com/paypay3/tukecholl-api/account/domain/transactiondomain"
+	"github.com/paypay3/tukecholl-api/account/domain/vo"
+	"github.com/paypay3/tukecholl-api/account/infrastructure/persistence/rdb"
+)
+
+const (
+	dateLayout     = "2006-01-02"
+	datetimeLayout = "2006-01-02 15:04:05"
+)
+
+type transactionDto struct {
+	ID                int            `db:"id"`
+	TransactionTypeID int            `db:"transaction_type_id"`
+	PostedDate        string         `db:"posted_date"`
+	UpdatedDate       string         `db:"updated_date"`
+	TransactionDate   string         `db:"transaction_date"`
+	Shop              sql.NullString `db:"shop"`
+	Memo              sql.NullString `db:"memo"`
+	Amount            int            `db:"amount"`
+	UserID            string         `db:"user_id"`
+	BigCategoryID     int            `db:"big_category_id"`
+	MediumCategoryID  sql.NullInt64  `db:"medium_category_id"`
+	CustomCategoryID  sql.NullInt64  `db:"custom_category_id"`
+}
+
+type bigCategoryTotalDto struct {
+	BigCategoryID int `db:"big_category_id"`
+	TotalAmount   int `db:"total_amount"`
+}
+
+type transactionRepository struct {
+	*rdb.Driver
+}
+
+func NewTransactionRepository(rdbDriver *rdb.Driver) *transactionRepository {
+	return &transactionRepository{rdbDriver}
+}
+
+// selectTransactionsQuery formats the dates in SQL so that scanning does not depend on the parseTime option of the DSN.
+const selectTransactionsQuery = `
+        SELECT
+            id,
+            transaction_type_id,
+            DATE_FORMAT(posted_date, '%Y-%m-%d %H:%i:%s') posted_date,
+            DATE_FORMAT(updated_date, '%Y-%m-%d %H:%i:%s') updated_date,
+            DATE_FORMAT(transaction_date, '%Y-%m-%d') transaction_date,
+            shop,
+            memo,
+            amount,
+            user_id,
+            big_category_id,
+            medium_category_id,
+            custom_category_id
+        FROM
+            transactions`
+
+func (r *transactionRepository) CreateTransaction(ctx context.Context, transaction *transactiondomain.Transaction) (int, error) {
+	query := `
+        INSERT INTO transactions
+            (transaction_type_id, transaction_date, shop, memo, amount, user_id, big_category_id, medium_category_id, custom_category_id)
+        VALUES
+            (?,?,?,?,?,?,?,?,?)`
+
+	result, err := r.Driver.Executor(ctx).ExecContext(ctx, query,
+		transaction.TransactionType(),
+		transaction.TransactionDate().Format(dateLayout),
+		toNullString(transaction.Shop().Value()),
+		toNullString(transaction.Memo().Value()),
+		transaction.Amount(),
+		transaction.UserID(),
+		transaction.BigCategoryID(),
+		toNullInt64(transaction.MediumCategoryID().Value()),
+		toNullInt64(transaction.CustomCategoryID().Value()),
+	)
+	if err != nil {
+		return 0, apperror.NewInternalError(err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, apperror.NewInternalError(err)
+	}
+
+	return int(id), nil
+}
+
+func (r *transactionRepository) GetTransaction(ctx context.Context, userID vo.UserID, transactionID int) (*transactiondomain.Transaction, error) {
+	query := selectTransactionsQuery + `
+        WHERE
+            id = ?
+        AND
+            user_id = ?`
+
+	var dto transactionDto
+	if err := r.Driver.Executor(ctx).GetContext(ctx, &dto, query, transactionID, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperror.NewNotFoundError(apperror.ReasonTransactionNotFound, "transaction not found: %d", transactionID)
+		}
+
+		return nil, apperror.NewInternalError(err)
+	}
+
+	transaction, err := toTransaction(dto)
+	if err != nil {
+		return nil, apperror.NewInternalError(err)
+	}
+
+	return transaction, nil
+}
+
+func (r *transactionRepository) GetMonthlyTransactions(ctx context.Context, userID vo.UserID, yearMonth vo.YearMonth) ([]*transactiondomain.Transaction, error) {
+	query := selectTransactionsQuery + `
+        WHERE
+            user_id = ?
+        AND
+            transaction_date BETWEEN ? AND LAST_DAY(?)
+        ORDER BY
+            transaction_date DESC, id DESC`
+
+	var transactionsDto []transactionDto
+	if err := r.Driver.Executor(ctx).SelectContext(ctx, &transactionsDto, query, userID, yearMonth.Value(), yearMonth.Value()); err != nil {
+		return nil, apperror.NewInternalError(err)
+	}
+
+	transactions := make([]*transactiondomain.Transaction, len(transactionsDto))
+	for i, dto := range transactionsDto {
+		transaction, err := toTransaction(dto)
+		if err != nil {
+			return nil, apperror.NewInternalError(err)
+		}
+
+		transactions[i] = transaction
+	}
+
+	return transactions, nil
+}
+
+func (r *transactionRepository) SearchTransactions(ctx context.Context, condition *transactiondomain.SearchCondition) ([]*transactiondomain.Transaction, error) {
+	query, args := buildSearchTransactionsQuery(condition)
+
+	var transactionsDto []transactionDto
+	if err := r.Driver.Executor(ctx).SelectContext(ctx, &transactionsDto, query, args...); err != nil {
+		return nil, apperror.NewInternalError(err)
+	}
+
+	transactions := make([]*transactiondomain.Transaction, len(transactionsDto))
+	for i, dto := range transactionsDto {
+		transaction, err := toTransaction(dto)
+		if err != nil {
+			return nil, apperror.NewInternalError(err)
+		}
+
+		transactions[i] = transaction
+	}
+
+	return transactions, nil
+}
+
+// buildSearchTransactionsQuery builds the query from the condition.
+// every value is passed as a placeholder argument, and the sort column and order are taken from fixed lists.
+func buildSearchTransactionsQuery(condition *transactiondomain.SearchCondition) (string, []interface{}) {
+	conditions := []string{"user_id = ?"}
+	args := []interface{}{condition.UserID}
+
+	if condition.TransactionType != 0 {
+		conditions = append(conditions, "transaction_type_id = ?")
+		args = append(args, condition.TransactionType)
+	}
+
+	if !condition.StartDate.IsZero() {
+		conditions = append(conditions, "transaction_date >= ?")
+		args = append(args, condition.StartDate.Format(dateLayout))
+	}
+
+	if !condition.EndDate.IsZero() {
+		conditions = append(conditions, "transaction_date <= ?")
+		args = append(args, condition.EndDate.Format(dateLayout))
+	}
+
+	if condition.BigCategoryID != 0 {
+		conditions = append(conditions, "big_category_id = ?")
+		args = append(args, condition.BigCategoryID)
+	}
+
+	if condition.MediumCategoryID != 0 {
+		conditions = append(conditions, "medium_category_id = ?")
+		args = append(args, condition.MediumCategoryID)
+	}
+
+	if condition.CustomCategoryID != 0 {
+		conditions = append(conditions, "custom_category_id = ?")
+		args = append(args, condition.CustomCategoryID)
+	}
+
+	if condition.LowAmount != 0 {
+		conditions = append(conditions, "amount >= ?")
+		args = append(args, condition.LowAmount)
+	}
+
+	if condition.HighAmount != 0 {
+		conditions = append(conditions, "amount <= ?")
+		args = append(args, condition.HighAmount)
+	}
+
+	if condition.Keyword != "" {
+		keyword := "%" + likeEscaper.Replace(condition.Keyword) + "%"
+		conditions = append(conditions, "(shop LIKE ? OR memo LIKE ?)")
+		args = append(args, keyword, keyword)
+	}
+
+	sortColumn, ok := transactionSortColumns[condition.SortField]
+	if !ok {
+		sortColumn = transactionSortColumns[transactiondomain.SortFieldTransactionDate]
+	}
+
+	sortOrder := "DESC"
+	if condition.SortOrder == transactiondomain.SortOrderAsc {
+		sortOrder = "ASC"
+	}
+
+	query := selectTransactionsQuery + `
+        WHERE
+            ` + strings.Join(conditions, `
+        AND
+            `) + `
+        ORDER BY
+            ` + sortColumn + " " + sortOrder + ", id " + sortOrder + `
+        LIMIT ?`
+	args = append(args, condition.Limit)
+
+	return query, args
+}
+
+var transactionSortColumns = map[transactiondomain.SortField]string{
+	transactiondomain.SortFieldTransactionDate: "transaction_date",
+	transactiondomain.SortFieldAmount:          "amount",
+	transactiondomain.SortFieldUpdatedDate:     "updated_date",
+}
+
+// likeEscaper escapes the wildcards of LIKE with the default escape character.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
+func (r *transactionRepository) GetMonthlyBigCategoryTotals(ctx context.Context, userID vo.UserID, yearMonth vo.YearMonth, transactionType vo.TransactionType) ([]*transactiondomain.BigCategoryTotal, error) {
+	query := `
+        SELECT
+            big_category_id,
+            SUM(amount) total_amount
+        FROM
+            transactions
+        WHERE
+            user_id = ?
+        AND
+            transaction_type_id = ?
+        AND
+            transaction_date BETWEEN ? AND LAST_DAY(?)
+        GROUP BY
+            big_category_id
+        ORDER BY
+            big_category_id`
+
+	var bigCategoryTotalsDto []bigCategoryTotalDto
+	if err := r.Driver.Executor(ctx).SelectContext(ctx, &bigCategoryTotalsDto, query, userID, transactionType, yearMonth.Value(), yearMonth.Value()); err != nil {
+		return nil, apperror.NewInternalError(err)
+	}
+
+	bigCategoryTotals := make([]*transactiondomain.BigCategoryTotal, len(bigCategoryTotalsDto))
+	for i, dto := range bigCategoryTotalsDto {
+		bigCategoryTotals[i] = transactiondomain.NewBigCategoryTotal(vo.BigCategoryID(dto.BigCategoryID), dto.TotalAmount)
+	}
+
+	return bigCategoryTotals, nil
+}
+
+func (r *transactionRepository) EditTransaction(ctx context.Context, transaction *transactiondomain.Transaction) error {
+	query := `
+        UPDATE
+            transactions
+        SET
+            transaction_type_id = ?,
+            transaction_date = ?,
+            shop = ?,
+            memo = ?,
+            amount = ?,
+            big_category_id = ?,
+            medium_category_id = ?,
+            custom_category_id = ?
+        WHERE
+            id = ?
+        AND
+            user_id = ?`
+
+	if _, err := r.Driver.Executor(ctx).ExecContext(ctx, query,
+		transaction.TransactionType(),
+		transaction.TransactionDate().Format(dateLayout),
+		toNullString(transaction.Shop().Value()),
+		toNullString(transaction.Memo().Value()),
+		transaction.Amount(),
+		transaction.BigCategoryID(),
+		toNullInt64(transaction.MediumCategoryID().Value()),
+		toNullInt64(transaction.CustomCategoryID().Value()),
+		transaction.ID(),
+		transaction.UserID(),
+	); err != nil {
+		return apperror.NewInternalError(err)
+	}
+
+	return nil
+}
+
+func (r *transactionRepository) DeleteTransaction(ctx context.Context, userID vo.UserID, transactionID int) error {
+	query := `
+        DELETE FROM
+            transactions
+        WHERE
+            id = ?
+        AND
+            user_id = ?`
+
+	result, err := r.Driver.Executor(ctx).ExecContext(ctx, query, transactionID, userID)
+	if err != nil {
+		return apperror.NewInternalError(err)
+	}
+
+	n, err := result.RowsAffected()
+	if err != nil {
+		return apperror.NewInternalError(err)
+	}
+
+	if n == 0 {
+		return apperror.NewNotFoundError(apperror.ReasonTransactionNotFound, "transaction not found: %d", transactionID)
+	}
+
+	return nil
+}
+
+func toTransaction(dto transactionDto) (*transactiondomain.Transaction, error) {
+	transactionType, err := vo.NewTransactionType(dto.TransactionTypeID)
+	if err != nil {
+		return nil, err
+	}
+
+	postedDate, err := time.Parse(datetimeLayout, dto.PostedDate)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedDate, err := time.Parse(datetimeLayout, dto.UpdatedDate)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionDate, err := time.Parse(dateLayout, dto.TransactionDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return transactiondomain.NewTransaction(
+		dto.ID,
+		transactionType,
+		postedDate,
+		updatedDate,
+		transactionDate,
+		vo.Shop(dto.Shop.String),
+		vo.Memo(dto.Memo.String),
+		vo.Amount(dto.Amount),
+		vo.UserID(dto.UserID),
+		vo.BigCategoryID(dto.BigCategoryID),
+		vo.MediumCategoryID(dto.MediumCategoryID.Int64),
+		vo.CustomCategoryID(dto.CustomCategoryID.Int64),
+	), nil
+}
+
+func toNullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
+func toNullInt64(i int) sql.NullInt64 {
+	return sql.NullInt64{Int64: int64(i), Valid: i != 0}
+}
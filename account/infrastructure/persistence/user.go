@@ -0,0 +1,74 @@
+package persistence
+
+import (
+	"context"
+
+	"github.com/paypay3/tukecholl-api/account/apperror"
+	"github.com/paypay3/tukecholl-api/account/domain/userdomain"
+	"github.com/paypay3/tukecholl-api/account/domain/vo"
+	"github.com/paypay3/tukecholl-api/account/infrastructure/persistence/rdb"
+)
+
+type userRepository struct {
+	*rdb.Driver
+}
+
+func NewUserRepository(rdbDriver *rdb.Driver) *userRepository {
+	return &userRepository{rdbDriver}
+}
+
+func (r *userRepository) CreateUser(ctx context.Context, user *userdomain.User) error {
+	query := `
+        INSERT INTO users
+            (id, name, email, password)
+        VALUES
+            (?,?,?,?)`
+
+	if _, err := r.Driver.Executor(ctx).ExecContext(ctx, query, user.ID(), user.Name(), user.Email(), user.Password()); err != nil {
+		if rdb.IsDuplicateEntryError(err) {
+			return apperror.NewConflictError(apperror.ReasonUserAlreadyExists, "user id or email already exists: %s", user.ID())
+		}
+
+		return apperror.NewInternalError(err)
+	}
+
+	return nil
+}
+
+func (r *userRepository) ExistsUserID(ctx context.Context, userID vo.UserID) (bool, error) {
+	query := `
+        SELECT EXISTS (
+            SELECT
+                1
+            FROM
+                users
+            WHERE
+                id = ?
+        )`
+
+	var exists bool
+	if err := r.Driver.Executor(ctx).GetContext(ctx, &exists, query, userID); err != nil {
+		return false, apperror.NewInternalError(err)
+	}
+
+	return exists, nil
+}
+
+func (r *userRepository) ExistsEmail(ctx context.Context, email vo.Email) (bool, error) {
+	query := `
+        SELECT EXISTS (
+            SELECT
+                1
+            FROM
+                users
+            WHERE
+                email = ?
+        )`
+
+	var exists bool
+	if err := r.Driver.Executor(ctx).GetContext(ctx, &exists, query, email); err != nil {
+		return false, apperror.NewInternalError(err)
+	}
+
+	return exists, nil
+}
@@ -1,8 +1,8 @@
 package server
 
 import (
+	"context"
 	"fmt"
-	"log"
 	"net"
 	"os"
 	"os/signal"
@@ -12,21 +12,49 @@ import (
 	"google.golang.org/grpc/reflection"
 
 	"github.com/paypay3/tukecholl-api/account/config"
+	"github.com/paypay3/tukecholl-api/account/domain/vo"
 	"github.com/paypay3/tukecholl-api/account/infrastructure/persistence/rdb"
+	"github.com/paypay3/tukecholl-api/account/logger"
 )
 
 func Run() error {
+	logLevel, err := logger.ParseLevel(config.Env.Log.Level)
+	if err != nil {
+		return err
+	}
+	logger.SetDefault(logger.New(os.Stderr, logLevel))
+
+	if config.Env.Validation.UserIDPattern != "" {
+		if err := vo.SetUserIDPattern(config.Env.Validation.UserIDPattern); err != nil {
+			return err
+		}
+	}
+
+	interceptors, err := newUnaryInterceptors()
+	if err != nil {
+		return err
+	}
+
 	rdbDriver, err := rdb.NewDriver()
 	if err != nil {
 		return err
 	}
 	defer rdbDriver.Conn.Close()
 
-	srv := grpc.NewServer()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv := grpc.NewServer(grpc.ChainUnaryInterceptor(interceptors...))
 
 	// register services to the server.
 	reflection.Register(srv)
 	registerBudgetServiceServer(srv, rdbDriver)
+	registerCategoryServiceServer(srv, rdbDriver)
+	registerGroupServiceServer(srv, rdbDriver)
+	registerRecurringTransactionServiceServer(srv, rdbDriver)
+	registerTodoServiceServer(srv, rdbDriver)
+	registerTransactionServiceServer(srv, rdbDriver)
+	registerUserServiceServer(srv, rdbDriver)
 
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", config.Env.Server.Port))
 	if err != nil {
@@ -34,6 +62,20 @@ func Run() error {
 	}
 	defer lis.Close()
 
+	schedulerDone := make(chan struct{})
+	go func() {
+		defer close(schedulerDone)
+		runRecurringTransactionScheduler(ctx, rdbDriver)
+	}()
+	// the scheduler is stopped and waited for before the connections are closed, so that no query runs on them.
+	// cancel aborts a running tick, whose unrecorded transactions are recorded by the next start since the execution is idempotent.
+	defer func() {
+		cancel()
+		<-schedulerDone
+	}()
+
+	logger.Info("server started", "port", config.Env.Server.Port)
+
 	errorCh := make(chan error, 1)
 	go func() {
 		if err := srv.Serve(lis); err != nil {
@@ -48,7 +90,7 @@ func Run() error {
 	case err := <-errorCh:
 		return err
 	case s := <-signalCh:
-		log.Printf("SIGNAL %s received", s.String())
+		logger.Info("signal received", "signal", s.String())
 		srv.GracefulStop()
 	}
 
@@ -0,0 +1,55 @@
+package server
+
+import (
+	"golang.org/x/xerrors"
+	"google.golang.org/grpc"
+
+	"github.com/paypay3/tukecholl-api/account/config"
+	"github.com/paypay3/tukecholl-api/account/interfaces/interceptor/auth"
+	"github.com/paypay3/tukecholl-api/account/interfaces/interceptor/errorstatus"
+	"github.com/paypay3/tukecholl-api/account/interfaces/interceptor/logging"
+	"github.com/paypay3/tukecholl-api/account/interfaces/interceptor/recovery"
+	"github.com/paypay3/tukecholl-api/account/interfaces/interceptor/requestid"
+	"github.com/paypay3/tukecholl-api/account/interfaces/interceptor/timeout"
+	"github.com/paypay3/tukecholl-api/account/interfaces/interceptor/validation"
+)
+
+// newUnaryInterceptors builds the chain of the interceptors in the order of config.Env.Interceptor.Interceptors.
+// the errorstatus interceptor is always the outermost one, so that every error, including the ones of the other
+// interceptors, reaches clients as a gRPC status.
+func newUnaryInterceptors() ([]grpc.UnaryServerInterceptor, error) {
+	cfg := config.Env.Interceptor
+
+	interceptors := []grpc.UnaryServerInterceptor{errorstatus.UnaryServerInterceptor()}
+	enabled := make(map[string]bool, len(cfg.Interceptors))
+
+	for _, name := range cfg.Interceptors {
+		if enabled[name] {
+			return nil, xerrors.Errorf("duplicate interceptor: %s", name)
+		}
+		enabled[name] = true
+
+		switch name {
+		case "requestid":
+			interceptors = append(interceptors, requestid.UnaryServerInterceptor())
+		case "logging":
+			interceptors = append(interceptors, logging.UnaryServerInterceptor())
+		case "recovery":
+			interceptors = append(interceptors, recovery.UnaryServerInterceptor())
+		case "timeout":
+			interceptors = append(interceptors, timeout.UnaryServerInterceptor(cfg.Timeout))
+		case "validation":
+			interceptors = append(interceptors, validation.UnaryServerInterceptor())
+		case "auth":
+			interceptor, err := auth.UnaryServerInterceptor(cfg.APIKeys)
+			if err != nil {
+				return nil, err
+			}
+			interceptors = append(interceptors, interceptor)
+		default:
+			return nil, xerrors.Errorf("unknown interceptor: %s", name)
+		}
+	}
+
+	return interceptors, nil
+}
@@ -1,19 +1,108 @@
 package server
 
 import (
+	"context"
+
 	"google.golang.org/grpc"
 
+	"github.com/paypay3/tukecholl-api/account/config"
 	"github.com/paypay3/tukecholl-api/account/infrastructure/persistence"
 	"github.com/paypay3/tukecholl-api/account/infrastructure/persistence/rdb"
 	"github.com/paypay3/tukecholl-api/account/interfaces/handler"
+	"github.com/paypay3/tukecholl-api/account/interfaces/scheduler"
+	"github.com/paypay3/tukecholl-api/account/logger"
 	"github.com/paypay3/tukecholl-api/account/usecase"
 	"github.com/paypay3/tukecholl-api/proto/accountproto"
+	"github.com/paypay3/tukecholl-api/proto/groupproto"
+	"github.com/paypay3/tukecholl-api/proto/todoproto"
+	"github.com/paypay3/tukecholl-api/proto/userproto"
 )
 
 func registerBudgetServiceServer(srv *grpc.Server, rdbDriver *rdb.Driver) {
 	budgetRepository := persistence.NewBudgetRepository(rdbDriver)
-	budgetUsecase := usecase.NewBudgetUsecase(budgetRepository)
+	categoryRepository := persistence.NewCategoryRepository(rdbDriver)
+	transactionRepository := persistence.NewTransactionRepository(rdbDriver)
+	groupRepository := persistence.NewGroupRepository(rdbDriver)
+	budgetUsecase := usecase.NewBudgetUsecase(rdbDriver, budgetRepository, categoryRepository, transactionRepository, groupRepository)
 	budgetHandler := handler.NewBudgetHandler(budgetUsecase)
 
 	accountproto.RegisterBudgetServiceServer(srv, budgetHandler)
 }
+
+func registerCategoryServiceServer(srv *grpc.Server, rdbDriver *rdb.Driver) {
+	categoryRepository := persistence.NewCategoryRepository(rdbDriver)
+	categoryUsecase := usecase.NewCategoryUsecase(categoryRepository)
+	categoryHandler := handler.NewCategoryHandler(categoryUsecase)
+
+	accountproto.RegisterCategoryServiceServer(srv, categoryHandler)
+}
+
+func registerGroupServiceServer(srv *grpc.Server, rdbDriver *rdb.Driver) {
+	groupRepository := persistence.NewGroupRepository(rdbDriver)
+	userRepository := persistence.NewUserRepository(rdbDriver)
+	budgetRepository := persistence.NewBudgetRepository(rdbDriver)
+	categoryRepository := persistence.NewCategoryRepository(rdbDriver)
+	transactionRepository := persistence.NewTransactionRepository(rdbDriver)
+	groupUsecase := usecase.NewGroupUsecase(rdbDriver, groupRepository, userRepository, budgetRepository, categoryRepository, transactionRepository)
+	groupHandler := handler.NewGroupHandler(groupUsecase)
+
+	groupproto.RegisterGroupServiceServer(srv, groupHandler)
+}
+
+func registerRecurringTransactionServiceServer(srv *grpc.Server, rdbDriver *rdb.Driver) {
+	recurringTransactionUsecase := newRecurringTransactionUsecase(rdbDriver)
+	recurringTransactionHandler := handler.NewRecurringTransactionHandler(recurringTransactionUsecase)
+
+	accountproto.RegisterRecurringTransactionServiceServer(srv, recurringTransactionHandler)
+}
+
+// runRecurringTransactionScheduler blocks until ctx is done.
+func runRecurringTransactionScheduler(ctx context.Context, rdbDriver *rdb.Driver) {
+	if config.Env.Scheduler.RecurringTransactionInterval <= 0 {
+		return
+	}
+
+	recurringTransactionUsecase := newRecurringTransactionUsecase(rdbDriver)
+	recurringTransactionScheduler := scheduler.NewRecurringTransactionScheduler(recurringTransactionUsecase, config.Env.Scheduler.RecurringTransactionInterval)
+
+	recurringTransactionScheduler.Run(logger.NewContext(ctx, logger.Default().With("scheduler", "recurring_transaction")))
+}
+
+func newRecurringTransactionUsecase(rdbDriver *rdb.Driver) usecase.RecurringTransactionUsecase {
+	recurringTransactionRepository := persistence.NewRecurringTransactionRepository(rdbDriver)
+	transactionRepository := persistence.NewTransactionRepository(rdbDriver)
+	categoryRepository := persistence.NewCategoryRepository(rdbDriver)
+
+	return usecase.NewRecurringTransactionUsecase(rdbDriver, recurringTransactionRepository, transactionRepository, categoryRepository)
+}
+
+func registerTodoServiceServer(srv *grpc.Server, rdbDriver *rdb.Driver) {
+	todoRepository := persistence.NewTodoRepository(rdbDriver)
+	groupRepository := persistence.NewGroupRepository(rdbDriver)
+	transactionRepository := persistence.NewTransactionRepository(rdbDriver)
+	categoryRepository := persistence.NewCategoryRepository(rdbDriver)
+	todoUsecase := usecase.NewTodoUsecase(rdbDriver, todoRepository, groupRepository, transactionRepository, categoryRepository)
+	todoHandler := handler.NewTodoHandler(todoUsecase)
+
+	todoproto.RegisterTodoServiceServer(srv, todoHandler)
+}
+
+func registerTransactionServiceServer(srv *grpc.Server, rdbDriver *rdb.Driver) {
+	transactionRepository := persistence.NewTransactionRepository(rdbDriver)
+	categoryRepository := persistence.NewCategoryRepository(rdbDriver)
+	groupRepository := persistence.NewGroupRepository(rdbDriver)
+	transactionUsecase := usecase.NewTransactionUsecase(transactionRepository, categoryRepository, groupRepository)
+	transactionHandler := handler.NewTransactionHandler(transactionUsecase)
+
+	accountproto.RegisterTransactionServiceServer(srv, transactionHandler)
+}
+
+func registerUserServiceServer(srv *grpc.Server, rdbDriver *rdb.Driver) {
+	userRepository := persistence.NewUserRepository(rdbDriver)
+	budgetRepository := persistence.NewBudgetRepository(rdbDriver)
+	categoryRepository := persistence.NewCategoryRepository(rdbDriver)
+	userUsecase := usecase.NewUserUsecase(rdbDriver, userRepository, budgetRepository, categoryRepository)
+	userHandler := handler.NewUserHandler(userUsecase)
+
+	userproto.RegisterUserServiceServer(srv, userHandler)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/paypay3/tukecholl-api/account/usecase"
 	"github.com/paypay3/tukecholl-api/account/usecase/input"
+	"github.com/paypay3/tukecholl-api/account/usecase/output"
 	"github.com/paypay3/tukecholl-api/proto/accountproto"
 )
 
@@ -22,9 +23,274 @@ func NewBudgetHandler(budgetUsecase usecase.BudgetUsecase) *budgetHandler {
 func (h *budgetHandler) CreateStandardBudgets(ctx context.Context, r *accountproto.CreateStandardBudgetsRequest) (*accountproto.CreateStandardBudgetsResponse, error) {
 	user := &input.User{ID: r.GetUserId()}
 
-	if err := h.budgetUsecase.CreateStandardBudgets(user); err != nil {
+	if err := h.budgetUsecase.CreateStandardBudgets(ctx, user); err != nil {
 		return nil, err
 	}
 
 	return &accountproto.CreateStandardBudgetsResponse{}, nil
 }
+
+func (h *budgetHandler) GetStandardBudgets(ctx context.Context, r *accountproto.GetStandardBudgetsRequest) (*accountproto.GetStandardBudgetsResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+
+	out, err := h.budgetUsecase.GetStandardBudgets(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountproto.GetStandardBudgetsResponse{
+		StandardBudgets: toStandardBudgetsProto(out),
+	}, nil
+}
+
+func (h *budgetHandler) EditStandardBudgets(ctx context.Context, r *accountproto.EditStandardBudgetsRequest) (*accountproto.EditStandardBudgetsResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+
+	in := &input.StandardBudgets{
+		StandardBudgets: make([]*input.StandardBudget, len(r.GetStandardBudgets())),
+	}
+	for i, standardBudget := range r.GetStandardBudgets() {
+		in.StandardBudgets[i] = &input.StandardBudget{
+			BigCategoryID: int(standardBudget.GetBigCategoryId()),
+			Budget:        int(standardBudget.GetBudget()),
+		}
+	}
+
+	out, err := h.budgetUsecase.EditStandardBudgets(ctx, user, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountproto.EditStandardBudgetsResponse{
+		StandardBudgets: toStandardBudgetsProto(out),
+	}, nil
+}
+
+func toStandardBudgetsProto(out *output.StandardBudgets) []*accountproto.StandardBudget {
+	standardBudgets := make([]*accountproto.StandardBudget, len(out.StandardBudgets))
+	for i, standardBudget := range out.StandardBudgets {
+		standardBudgets[i] = &accountproto.StandardBudget{
+			BigCategoryId:   int64(standardBudget.BigCategoryID),
+			BigCategoryName: standardBudget.BigCategoryName,
+			Budget:          int64(standardBudget.Budget),
+		}
+	}
+
+	return standardBudgets
+}
+
+func (h *budgetHandler) CreateCustomBudgets(ctx context.Context, r *accountproto.CreateCustomBudgetsRequest) (*accountproto.CreateCustomBudgetsResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	in := toCustomBudgetsInput(r.GetYearsMonths(), r.GetCustomBudgets())
+
+	out, err := h.budgetUsecase.CreateCustomBudgets(ctx, user, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountproto.CreateCustomBudgetsResponse{
+		YearsMonths:   out.YearMonth,
+		CustomBudgets: toCustomBudgetsProto(out),
+	}, nil
+}
+
+func (h *budgetHandler) GetCustomBudgets(ctx context.Context, r *accountproto.GetCustomBudgetsRequest) (*accountproto.GetCustomBudgetsResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	in := &input.CustomBudgets{YearMonth: r.GetYearsMonths()}
+
+	out, err := h.budgetUsecase.GetCustomBudgets(ctx, user, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountproto.GetCustomBudgetsResponse{
+		YearsMonths:   out.YearMonth,
+		BudgetType:    toBudgetTypeProto(out.BudgetType),
+		CustomBudgets: toCustomBudgetsProto(out),
+	}, nil
+}
+
+func (h *budgetHandler) EditCustomBudgets(ctx context.Context, r *accountproto.EditCustomBudgetsRequest) (*accountproto.EditCustomBudgetsResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	in := toCustomBudgetsInput(r.GetYearsMonths(), r.GetCustomBudgets())
+
+	out, err := h.budgetUsecase.EditCustomBudgets(ctx, user, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountproto.EditCustomBudgetsResponse{
+		YearsMonths:   out.YearMonth,
+		CustomBudgets: toCustomBudgetsProto(out),
+	}, nil
+}
+
+func (h *budgetHandler) DeleteCustomBudgets(ctx context.Context, r *accountproto.DeleteCustomBudgetsRequest) (*accountproto.DeleteCustomBudgetsResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	in := &input.CustomBudgets{YearMonth: r.GetYearsMonths()}
+
+	if err := h.budgetUsecase.DeleteCustomBudgets(ctx, user, in); err != nil {
+		return nil, err
+	}
+
+	return &accountproto.DeleteCustomBudgetsResponse{}, nil
+}
+
+func toCustomBudgetsInput(yearMonth string, customBudgets []*accountproto.CustomBudget) *input.CustomBudgets {
+	in := &input.CustomBudgets{
+		YearMonth:     yearMonth,
+		CustomBudgets: make([]*input.CustomBudget, len(customBudgets)),
+	}
+
+	for i, customBudget := range customBudgets {
+		in.CustomBudgets[i] = &input.CustomBudget{
+			BigCategoryID: int(customBudget.GetBigCategoryId()),
+			Budget:        int(customBudget.GetBudget()),
+		}
+	}
+
+	return in
+}
+
+func toCustomBudgetsProto(out *output.CustomBudgets) []*accountproto.CustomBudget {
+	customBudgets := make([]*accountproto.CustomBudget, len(out.CustomBudgets))
+	for i, customBudget := range out.CustomBudgets {
+		customBudgets[i] = &accountproto.CustomBudget{
+			BigCategoryId:   int64(customBudget.BigCategoryID),
+			BigCategoryName: customBudget.BigCategoryName,
+			Budget:          int64(customBudget.Budget),
+		}
+	}
+
+	return customBudgets
+}
+
+func toBudgetTypeProto(budgetType output.BudgetType) accountproto.BudgetType {
+	switch budgetType {
+	case output.BudgetTypeStandard:
+		return accountproto.BudgetType_BUDGET_TYPE_STANDARD
+	case output.BudgetTypeCustom:
+		return accountproto.BudgetType_BUDGET_TYPE_CUSTOM
+	default:
+		return accountproto.BudgetType_BUDGET_TYPE_UNSPECIFIED
+	}
+}
+
+func (h *budgetHandler) GetYearlyBudget(ctx context.Context, r *accountproto.GetYearlyBudgetRequest) (*accountproto.GetYearlyBudgetResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	in := &input.YearlyBudget{Year: int(r.GetYear())}
+
+	out, err := h.budgetUsecase.GetYearlyBudget(ctx, user, in)
+	if err != nil {
+		return nil, err
+	}
+
+	monthlyBudgets := make([]*accountproto.MonthlyBudget, len(out.MonthlyBudgets))
+	for i, monthlyBudget := range out.MonthlyBudgets {
+		monthlyBudgets[i] = &accountproto.MonthlyBudget{
+			YearsMonths:        monthlyBudget.YearMonth,
+			BudgetType:         toBudgetTypeProto(monthlyBudget.BudgetType),
+			TotalBudget:        int64(monthlyBudget.TotalBudget),
+			BigCategoryBudgets: toBigCategoryBudgetsProto(monthlyBudget.BigCategoryBudgets),
+		}
+	}
+
+	return &accountproto.GetYearlyBudgetResponse{
+		Year:               int32(out.Year),
+		TotalBudget:        int64(out.TotalBudget),
+		BigCategoryBudgets: toBigCategoryBudgetsProto(out.BigCategoryBudgets),
+		MonthlyBudgets:     monthlyBudgets,
+	}, nil
+}
+
+func toBigCategoryBudgetsProto(outBigCategoryBudgets []*output.BigCategoryBudget) []*accountproto.BigCategoryBudget {
+	bigCategoryBudgets := make([]*accountproto.BigCategoryBudget, len(outBigCategoryBudgets))
+	for i, bigCategoryBudget := range outBigCategoryBudgets {
+		bigCategoryBudgets[i] = &accountproto.BigCategoryBudget{
+			BigCategoryId:   int64(bigCategoryBudget.BigCategoryID),
+			BigCategoryName: bigCategoryBudget.BigCategoryName,
+			Budget:          int64(bigCategoryBudget.Budget),
+		}
+	}
+
+	return bigCategoryBudgets
+}
+
+func (h *budgetHandler) GetBudgetStatus(ctx context.Context, r *accountproto.GetBudgetStatusRequest) (*accountproto.GetBudgetStatusResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	in := &input.BudgetStatus{YearMonth: r.GetYearsMonths()}
+
+	out, err := h.budgetUsecase.GetBudgetStatus(ctx, user, in)
+	if err != nil {
+		return nil, err
+	}
+
+	bigCategoryBudgetStatuses := make([]*accountproto.BigCategoryBudgetStatus, len(out.BigCategoryBudgetStatuses))
+	for i, bigCategoryBudgetStatus := range out.BigCategoryBudgetStatuses {
+		bigCategoryBudgetStatuses[i] = &accountproto.BigCategoryBudgetStatus{
+			BigCategoryId:   int64(bigCategoryBudgetStatus.BigCategoryID),
+			BigCategoryName: bigCategoryBudgetStatus.BigCategoryName,
+			Budget:          int64(bigCategoryBudgetStatus.Budget),
+			Expense:         int64(bigCategoryBudgetStatus.Expense),
+			Remaining:       int64(bigCategoryBudgetStatus.Remaining),
+		}
+	}
+
+	return &accountproto.GetBudgetStatusResponse{
+		YearsMonths:               out.YearMonth,
+		BudgetType:                toBudgetTypeProto(out.BudgetType),
+		TotalBudget:               int64(out.TotalBudget),
+		TotalExpense:              int64(out.TotalExpense),
+		TotalRemaining:            int64(out.TotalRemaining),
+		BigCategoryBudgetStatuses: bigCategoryBudgetStatuses,
+	}, nil
+}
+
+func (h *budgetHandler) CreateGroupStandardBudgets(ctx context.Context, r *accountproto.CreateGroupStandardBudgetsRequest) (*accountproto.CreateGroupStandardBudgetsResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	group := &input.Group{ID: int(r.GetGroupId())}
+
+	if err := h.budgetUsecase.CreateGroupStandardBudgets(ctx, user, group); err != nil {
+		return nil, err
+	}
+
+	return &accountproto.CreateGroupStandardBudgetsResponse{}, nil
+}
+
+func (h *budgetHandler) GetGroupStandardBudgets(ctx context.Context, r *accountproto.GetGroupStandardBudgetsRequest) (*accountproto.GetGroupStandardBudgetsResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	group := &input.Group{ID: int(r.GetGroupId())}
+
+	out, err := h.budgetUsecase.GetGroupStandardBudgets(ctx, user, group)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountproto.GetGroupStandardBudgetsResponse{
+		StandardBudgets: toStandardBudgetsProto(out),
+	}, nil
+}
+
+func (h *budgetHandler) EditGroupStandardBudgets(ctx context.Context, r *accountproto.EditGroupStandardBudgetsRequest) (*accountproto.EditGroupStandardBudgetsResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	group := &input.Group{ID: int(r.GetGroupId())}
+
+	in := &input.StandardBudgets{
+		StandardBudgets: make([]*input.StandardBudget, len(r.GetStandardBudgets())),
+	}
+	for i, standardBudget := range r.GetStandardBudgets() {
+		in.StandardBudgets[i] = &input.StandardBudget{
+			BigCategoryID: int(standardBudget.GetBigCategoryId()),
+			Budget:        int(standardBudget.GetBudget()),
+		}
+	}
+
+	out, err := h.budgetUsecase.EditGroupStandardBudgets(ctx, user, group, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountproto.EditGroupStandardBudgetsResponse{
+		StandardBudgets: toStandardBudgetsProto(out),
+	}, nil
+}
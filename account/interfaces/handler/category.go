@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/paypay3/tukecholl-api/account/usecase"
+	"github.com/paypay3/tukecholl-api/account/usecase/input"
+	"github.com/paypay3/tukecholl-api/account/usecase/output"
+	"github.com/paypay3/tukecholl-api/proto/accountproto"
+)
+
+type categoryHandler struct {
+	categoryUsecase usecase.CategoryUsecase
+	accountproto.UnimplementedCategoryServiceServer
+}
+
+func NewCategoryHandler(categoryUsecase usecase.CategoryUsecase) *categoryHandler {
+	return &categoryHandler{
+		categoryUsecase: categoryUsecase,
+	}
+}
+
+func (h *categoryHandler) ListCategories(ctx context.Context, r *accountproto.ListCategoriesRequest) (*accountproto.ListCategoriesResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	in := &input.Categories{TransactionType: int(r.GetTransactionType())}
+
+	out, err := h.categoryUsecase.ListCategories(ctx, user, in)
+	if err != nil {
+		return nil, err
+	}
+
+	bigCategories := make([]*accountproto.BigCategory, len(out.BigCategories))
+	for i, bigCategory := range out.BigCategories {
+		mediumCategories := make([]*accountproto.MediumCategory, len(bigCategory.MediumCategories))
+		for j, mediumCategory := range bigCategory.MediumCategories {
+			mediumCategories[j] = &accountproto.MediumCategory{
+				Id:            int64(mediumCategory.ID),
+				Name:          mediumCategory.Name,
+				BigCategoryId: int64(mediumCategory.BigCategoryID),
+			}
+		}
+
+		customCategories := make([]*accountproto.CustomCategory, len(bigCategory.CustomCategories))
+		for j, customCategory := range bigCategory.CustomCategories {
+			customCategories[j] = toCustomCategoryProto(customCategory)
+		}
+
+		bigCategories[i] = &accountproto.BigCategory{
+			Id:               int64(bigCategory.ID),
+			Name:             bigCategory.Name,
+			TransactionType:  toTransactionTypeProto(bigCategory.TransactionType),
+			MediumCategories: mediumCategories,
+			CustomCategories: customCategories,
+		}
+	}
+
+	return &accountproto.ListCategoriesResponse{
+		BigCategories: bigCategories,
+	}, nil
+}
+
+func (h *categoryHandler) CreateCustomCategory(ctx context.Context, r *accountproto.CreateCustomCategoryRequest) (*accountproto.CreateCustomCategoryResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	in := &input.CustomCategory{
+		BigCategoryID: int(r.GetBigCategoryId()),
+		Name:          r.GetName(),
+	}
+
+	out, err := h.categoryUsecase.CreateCustomCategory(ctx, user, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountproto.CreateCustomCategoryResponse{
+		CustomCategory: toCustomCategoryProto(out),
+	}, nil
+}
+
+func (h *categoryHandler) EditCustomCategory(ctx context.Context, r *accountproto.EditCustomCategoryRequest) (*accountproto.EditCustomCategoryResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	in := &input.CustomCategory{
+		ID:   int(r.GetId()),
+		Name: r.GetName(),
+	}
+
+	out, err := h.categoryUsecase.EditCustomCategory(ctx, user, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountproto.EditCustomCategoryResponse{
+		CustomCategory: toCustomCategoryProto(out),
+	}, nil
+}
+
+func (h *categoryHandler) DeleteCustomCategory(ctx context.Context, r *accountproto.DeleteCustomCategoryRequest) (*accountproto.DeleteCustomCategoryResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	in := &input.CustomCategory{ID: int(r.GetId())}
+
+	if err := h.categoryUsecase.DeleteCustomCategory(ctx, user, in); err != nil {
+		return nil, err
+	}
+
+	return &accountproto.DeleteCustomCategoryResponse{}, nil
+}
+
+func toCustomCategoryProto(customCategory *output.CustomCategory) *accountproto.CustomCategory {
+	return &accountproto.CustomCategory{
+		Id:            int64(customCategory.ID),
+		Name:          customCategory.Name,
+		BigCategoryId: int64(customCategory.BigCategoryID),
+	}
+}
+
+func toTransactionTypeProto(transactionType output.TransactionType) accountproto.TransactionType {
+	switch transactionType {
+	case output.TransactionTypeIncome:
+		return accountproto.TransactionType_TRANSACTION_TYPE_INCOME
+	case output.TransactionTypeExpense:
+		return accountproto.TransactionType_TRANSACTION_TYPE_EXPENSE
+	default:
+		return accountproto.TransactionType_TRANSACTION_TYPE_UNSPECIFIED
+	}
+}
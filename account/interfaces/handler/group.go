@@ -0,0 +1,179 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/paypay3/tukecholl-api/account/usecase"
+	"github.com/paypay3/tukecholl-api/account/usecase/input"
+	"github.com/paypay3/tukecholl-api/account/usecase/output"
+	"github.com/paypay3/tukecholl-api/proto/groupproto"
+)
+
+type groupHandler struct {
+	groupUsecase usecase.GroupUsecase
+	groupproto.UnimplementedGroupServiceServer
+}
+
+func NewGroupHandler(groupUsecase usecase.GroupUsecase) *groupHandler {
+	return &groupHandler{
+		groupUsecase: groupUsecase,
+	}
+}
+
+func (h *groupHandler) CreateGroup(ctx context.Context, r *groupproto.CreateGroupRequest) (*groupproto.CreateGroupResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	in := &input.Group{Name: r.GetGroupName()}
+
+	out, err := h.groupUsecase.CreateGroup(ctx, user, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &groupproto.CreateGroupResponse{
+		Group: toGroupProto(out),
+	}, nil
+}
+
+func (h *groupHandler) ListGroups(ctx context.Context, r *groupproto.ListGroupsRequest) (*groupproto.ListGroupsResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+
+	out, err := h.groupUsecase.ListGroups(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return &groupproto.ListGroupsResponse{
+		ApprovedGroups:   toGroupsProto(out.ApprovedGroups),
+		UnapprovedGroups: toGroupsProto(out.UnapprovedGroups),
+	}, nil
+}
+
+func (h *groupHandler) InviteUser(ctx context.Context, r *groupproto.InviteUserRequest) (*groupproto.InviteUserResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	in := &input.Invitation{
+		GroupID:       int(r.GetGroupId()),
+		InviteeUserID: r.GetInviteeUserId(),
+	}
+
+	if err := h.groupUsecase.InviteUser(ctx, user, in); err != nil {
+		return nil, err
+	}
+
+	return &groupproto.InviteUserResponse{}, nil
+}
+
+func (h *groupHandler) AcceptInvitation(ctx context.Context, r *groupproto.AcceptInvitationRequest) (*groupproto.AcceptInvitationResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	in := &input.Group{ID: int(r.GetGroupId())}
+
+	out, err := h.groupUsecase.AcceptInvitation(ctx, user, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &groupproto.AcceptInvitationResponse{
+		Group: toGroupProto(out),
+	}, nil
+}
+
+func (h *groupHandler) DeclineInvitation(ctx context.Context, r *groupproto.DeclineInvitationRequest) (*groupproto.DeclineInvitationResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	in := &input.Group{ID: int(r.GetGroupId())}
+
+	if err := h.groupUsecase.DeclineInvitation(ctx, user, in); err != nil {
+		return nil, err
+	}
+
+	return &groupproto.DeclineInvitationResponse{}, nil
+}
+
+func (h *groupHandler) LeaveGroup(ctx context.Context, r *groupproto.LeaveGroupRequest) (*groupproto.LeaveGroupResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	in := &input.Group{ID: int(r.GetGroupId())}
+
+	if err := h.groupUsecase.LeaveGroup(ctx, user, in); err != nil {
+		return nil, err
+	}
+
+	return &groupproto.LeaveGroupResponse{}, nil
+}
+
+func (h *groupHandler) ListMembers(ctx context.Context, r *groupproto.ListMembersRequest) (*groupproto.ListMembersResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	in := &input.Group{ID: int(r.GetGroupId())}
+
+	out, err := h.groupUsecase.ListMembers(ctx, user, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &groupproto.ListMembersResponse{
+		ApprovedMembers:   toMembersProto(out.ApprovedMembers),
+		UnapprovedMembers: toMembersProto(out.UnapprovedMembers),
+	}, nil
+}
+
+func (h *groupHandler) GetGroupAccountsSettlement(ctx context.Context, r *groupproto.GetGroupAccountsSettlementRequest) (*groupproto.GetGroupAccountsSettlementResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	group := &input.Group{ID: int(r.GetGroupId())}
+	in := &input.GroupAccountsSettlement{YearMonth: r.GetYearsMonths()}
+
+	out, err := h.groupUsecase.GetGroupAccountsSettlement(ctx, user, group, in)
+	if err != nil {
+		return nil, err
+	}
+
+	memberAccounts := make([]*groupproto.MemberAccount, len(out.MemberAccounts))
+	for i, memberAccount := range out.MemberAccounts {
+		memberAccounts[i] = &groupproto.MemberAccount{
+			UserId:     memberAccount.UserID,
+			PaidAmount: int64(memberAccount.PaidAmount),
+			FairShare:  int64(memberAccount.FairShare),
+			Balance:    int64(memberAccount.Balance),
+		}
+	}
+
+	transfers := make([]*groupproto.SettlementTransfer, len(out.Transfers))
+	for i, transfer := range out.Transfers {
+		transfers[i] = &groupproto.SettlementTransfer{
+			PayerUserId:     transfer.PayerUserID,
+			RecipientUserId: transfer.RecipientUserID,
+			Amount:          int64(transfer.Amount),
+		}
+	}
+
+	return &groupproto.GetGroupAccountsSettlementResponse{
+		YearsMonths:    out.YearMonth,
+		TotalExpense:   int64(out.TotalExpense),
+		MemberAccounts: memberAccounts,
+		Transfers:      transfers,
+	}, nil
+}
+
+func toGroupProto(group *output.Group) *groupproto.Group {
+	return &groupproto.Group{
+		Id:   int64(group.ID),
+		Name: group.Name,
+	}
+}
+
+func toGroupsProto(groups []*output.Group) []*groupproto.Group {
+	groupsProto := make([]*groupproto.Group, len(groups))
+	for i, group := range groups {
+		groupsProto[i] = toGroupProto(group)
+	}
+
+	return groupsProto
+}
+
+func toMembersProto(members []*output.Member) []*groupproto.Member {
+	membersProto := make([]*groupproto.Member, len(members))
+	for i, member := range members {
+		membersProto[i] = &groupproto.Member{
+			UserId:   member.UserID,
+			UserName: member.UserName,
+		}
+	}
+
+	return membersProto
+}
@@ -0,0 +1,151 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/paypay3/tukecholl-api/account/usecase"
+	"github.com/paypay3/tukecholl-api/account/usecase/input"
+	"github.com/paypay3/tukecholl-api/account/usecase/output"
+	"github.com/paypay3/tukecholl-api/proto/accountproto"
+)
+
+type recurringTransactionHandler struct {
+	recurringTransactionUsecase usecase.RecurringTransactionUsecase
+	accountproto.UnimplementedRecurringTransactionServiceServer
+}
+
+func NewRecurringTransactionHandler(recurringTransactionUsecase usecase.RecurringTransactionUsecase) *recurringTransactionHandler {
+	return &recurringTransactionHandler{
+		recurringTransactionUsecase: recurringTransactionUsecase,
+	}
+}
+
+func (h *recurringTransactionHandler) CreateRecurringTransaction(ctx context.Context, r *accountproto.CreateRecurringTransactionRequest) (*accountproto.CreateRecurringTransactionResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	in := &input.RecurringTransaction{
+		TransactionType:  int(r.GetTransactionType()),
+		Shop:             r.GetShop(),
+		Memo:             r.GetMemo(),
+		Amount:           int(r.GetAmount()),
+		BigCategoryID:    int(r.GetBigCategoryId()),
+		MediumCategoryID: int(r.GetMediumCategoryId()),
+		CustomCategoryID: int(r.GetCustomCategoryId()),
+		RecurrenceType:   int(r.GetRecurrenceType()),
+		DayOfMonth:       int(r.GetDayOfMonth()),
+		DayOfWeek:        int(r.GetDayOfWeek()),
+		StartDate:        r.GetStartDate(),
+		EndDate:          r.GetEndDate(),
+	}
+
+	out, err := h.recurringTransactionUsecase.CreateRecurringTransaction(ctx, user, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountproto.CreateRecurringTransactionResponse{
+		RecurringTransaction: toRecurringTransactionProto(out),
+	}, nil
+}
+
+func (h *recurringTransactionHandler) ListRecurringTransactions(ctx context.Context, r *accountproto.ListRecurringTransactionsRequest) (*accountproto.ListRecurringTransactionsResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+
+	out, err := h.recurringTransactionUsecase.ListRecurringTransactions(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	recurringTransactions := make([]*accountproto.RecurringTransaction, len(out.RecurringTransactions))
+	for i, recurringTransaction := range out.RecurringTransactions {
+		recurringTransactions[i] = toRecurringTransactionProto(recurringTransaction)
+	}
+
+	return &accountproto.ListRecurringTransactionsResponse{
+		RecurringTransactions: recurringTransactions,
+	}, nil
+}
+
+func (h *recurringTransactionHandler) EditRecurringTransaction(ctx context.Context, r *accountproto.EditRecurringTransactionRequest) (*accountproto.EditRecurringTransactionResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	in := &input.RecurringTransaction{
+		ID:               int(r.GetId()),
+		TransactionType:  int(r.GetTransactionType()),
+		Shop:             r.GetShop(),
+		Memo:             r.GetMemo(),
+		Amount:           int(r.GetAmount()),
+		BigCategoryID:    int(r.GetBigCategoryId()),
+		MediumCategoryID: int(r.GetMediumCategoryId()),
+		CustomCategoryID: int(r.GetCustomCategoryId()),
+		RecurrenceType:   int(r.GetRecurrenceType()),
+		DayOfMonth:       int(r.GetDayOfMonth()),
+		DayOfWeek:        int(r.GetDayOfWeek()),
+		StartDate:        r.GetStartDate(),
+		EndDate:          r.GetEndDate(),
+	}
+
+	out, err := h.recurringTransactionUsecase.EditRecurringTransaction(ctx, user, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountproto.EditRecurringTransactionResponse{
+		RecurringTransaction: toRecurringTransactionProto(out),
+	}, nil
+}
+
+func (h *recurringTransactionHandler) DeleteRecurringTransaction(ctx context.Context, r *accountproto.DeleteRecurringTransactionRequest) (*accountproto.DeleteRecurringTransactionResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	in := &input.RecurringTransaction{ID: int(r.GetId())}
+
+	if err := h.recurringTransactionUsecase.DeleteRecurringTransaction(ctx, user, in); err != nil {
+		return nil, err
+	}
+
+	return &accountproto.DeleteRecurringTransactionResponse{}, nil
+}
+
+func (h *recurringTransactionHandler) ExecuteRecurringTransactions(ctx context.Context, r *accountproto.ExecuteRecurringTransactionsRequest) (*accountproto.ExecuteRecurringTransactionsResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+
+	out, err := h.recurringTransactionUsecase.ExecuteRecurringTransactions(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountproto.ExecuteRecurringTransactionsResponse{
+		Transactions: toTransactionsProto(out),
+	}, nil
+}
+
+func toRecurringTransactionProto(recurringTransaction *output.RecurringTransaction) *accountproto.RecurringTransaction {
+	return &accountproto.RecurringTransaction{
+		Id:                 int64(recurringTransaction.ID),
+		TransactionType:    toTransactionTypeProto(recurringTransaction.TransactionType),
+		Shop:               recurringTransaction.Shop,
+		Memo:               recurringTransaction.Memo,
+		Amount:             int64(recurringTransaction.Amount),
+		BigCategoryId:      int64(recurringTransaction.BigCategoryID),
+		BigCategoryName:    recurringTransaction.BigCategoryName,
+		MediumCategoryId:   int64(recurringTransaction.MediumCategoryID),
+		MediumCategoryName: recurringTransaction.MediumCategoryName,
+		CustomCategoryId:   int64(recurringTransaction.CustomCategoryID),
+		CustomCategoryName: recurringTransaction.CustomCategoryName,
+		RecurrenceType:     toRecurrenceTypeProto(recurringTransaction.RecurrenceType),
+		DayOfMonth:         int32(recurringTransaction.DayOfMonth),
+		DayOfWeek:          int32(recurringTransaction.DayOfWeek),
+		StartDate:          recurringTransaction.StartDate,
+		EndDate:            recurringTransaction.EndDate,
+		LastExecutionDate:  recurringTransaction.LastExecutionDate,
+	}
+}
+
+func toRecurrenceTypeProto(recurrenceType output.RecurrenceType) accountproto.RecurrenceType {
+	switch recurrenceType {
+	case output.RecurrenceTypeMonthly:
+		return accountproto.RecurrenceType_RECURRENCE_TYPE_MONTHLY
+	case output.RecurrenceTypeWeekly:
+		return accountproto.RecurrenceType_RECURRENCE_TYPE_WEEKLY
+	default:
+		return accountproto.RecurrenceType_RECURRENCE_TYPE_UNSPECIFIED
+	}
+}
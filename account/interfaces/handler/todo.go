@@ -0,0 +1,268 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/paypay3/tukecholl-api/account/usecase"
+	"github.com/paypay3/tukecholl-api/account/usecase/input"
+	"github.com/paypay3/tukecholl-api/account/usecase/output"
+	"github.com/paypay3/tukecholl-api/proto/todoproto"
+)
+
+type todoHandler struct {
+	todoUsecase usecase.TodoUsecase
+	todoproto.UnimplementedTodoServiceServer
+}
+
+func NewTodoHandler(todoUsecase usecase.TodoUsecase) *todoHandler {
+	return &todoHandler{
+		todoUsecase: todoUsecase,
+	}
+}
+
+func (h *todoHandler) CreateTodo(ctx context.Context, r *todoproto.CreateTodoRequest) (*todoproto.CreateTodoResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	group := &input.Group{ID: int(r.GetGroupId())}
+	in := &input.Todo{
+		ImplementationDate: r.GetImplementationDate(),
+		DueDate:            r.GetDueDate(),
+		Content:            r.GetContent(),
+		AssigneeUserID:     r.GetAssigneeUserId(),
+	}
+
+	out, err := h.todoUsecase.CreateTodo(ctx, user, group, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &todoproto.CreateTodoResponse{
+		Todo: toTodoProto(out),
+	}, nil
+}
+
+func (h *todoHandler) EditTodo(ctx context.Context, r *todoproto.EditTodoRequest) (*todoproto.EditTodoResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	group := &input.Group{ID: int(r.GetGroupId())}
+	in := &input.Todo{
+		ID:                 int(r.GetId()),
+		ImplementationDate: r.GetImplementationDate(),
+		DueDate:            r.GetDueDate(),
+		Content:            r.GetContent(),
+		AssigneeUserID:     r.GetAssigneeUserId(),
+	}
+
+	out, err := h.todoUsecase.EditTodo(ctx, user, group, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &todoproto.EditTodoResponse{
+		Todo: toTodoProto(out),
+	}, nil
+}
+
+func (h *todoHandler) DeleteTodo(ctx context.Context, r *todoproto.DeleteTodoRequest) (*todoproto.DeleteTodoResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	group := &input.Group{ID: int(r.GetGroupId())}
+	in := &input.Todo{ID: int(r.GetId())}
+
+	if err := h.todoUsecase.DeleteTodo(ctx, user, group, in); err != nil {
+		return nil, err
+	}
+
+	return &todoproto.DeleteTodoResponse{}, nil
+}
+
+func (h *todoHandler) ChangeTodoCompletion(ctx context.Context, r *todoproto.ChangeTodoCompletionRequest) (*todoproto.ChangeTodoCompletionResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	group := &input.Group{ID: int(r.GetGroupId())}
+	in := &input.Completion{
+		ID:        int(r.GetId()),
+		Completed: r.GetCompleted(),
+	}
+
+	out, err := h.todoUsecase.ChangeTodoCompletion(ctx, user, group, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &todoproto.ChangeTodoCompletionResponse{
+		Todo: toTodoProto(out),
+	}, nil
+}
+
+func (h *todoHandler) ListTodos(ctx context.Context, r *todoproto.ListTodosRequest) (*todoproto.ListTodosResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	group := &input.Group{ID: int(r.GetGroupId())}
+	in := &input.Period{
+		YearMonth: r.GetYearsMonths(),
+		Date:      r.GetDate(),
+	}
+
+	out, err := h.todoUsecase.ListTodos(ctx, user, group, in)
+	if err != nil {
+		return nil, err
+	}
+
+	todos := make([]*todoproto.Todo, len(out.Todos))
+	for i, todo := range out.Todos {
+		todos[i] = toTodoProto(todo)
+	}
+
+	return &todoproto.ListTodosResponse{
+		Todos: todos,
+	}, nil
+}
+
+func (h *todoHandler) CreateShoppingItem(ctx context.Context, r *todoproto.CreateShoppingItemRequest) (*todoproto.CreateShoppingItemResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	group := &input.Group{ID: int(r.GetGroupId())}
+	in := &input.ShoppingItem{
+		ExpectedPurchaseDate: r.GetExpectedPurchaseDate(),
+		Name:                 r.GetName(),
+		Shop:                 r.GetShop(),
+		Amount:               int(r.GetAmount()),
+		AssigneeUserID:       r.GetAssigneeUserId(),
+		BigCategoryID:        int(r.GetBigCategoryId()),
+		MediumCategoryID:     int(r.GetMediumCategoryId()),
+	}
+
+	out, err := h.todoUsecase.CreateShoppingItem(ctx, user, group, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &todoproto.CreateShoppingItemResponse{
+		ShoppingItem: toShoppingItemProto(out),
+	}, nil
+}
+
+func (h *todoHandler) EditShoppingItem(ctx context.Context, r *todoproto.EditShoppingItemRequest) (*todoproto.EditShoppingItemResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	group := &input.Group{ID: int(r.GetGroupId())}
+	in := &input.ShoppingItem{
+		ID:                   int(r.GetId()),
+		ExpectedPurchaseDate: r.GetExpectedPurchaseDate(),
+		Name:                 r.GetName(),
+		Shop:                 r.GetShop(),
+		Amount:               int(r.GetAmount()),
+		AssigneeUserID:       r.GetAssigneeUserId(),
+		BigCategoryID:        int(r.GetBigCategoryId()),
+		MediumCategoryID:     int(r.GetMediumCategoryId()),
+	}
+
+	out, err := h.todoUsecase.EditShoppingItem(ctx, user, group, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &todoproto.EditShoppingItemResponse{
+		ShoppingItem: toShoppingItemProto(out),
+	}, nil
+}
+
+func (h *todoHandler) DeleteShoppingItem(ctx context.Context, r *todoproto.DeleteShoppingItemRequest) (*todoproto.DeleteShoppingItemResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	group := &input.Group{ID: int(r.GetGroupId())}
+	in := &input.ShoppingItem{ID: int(r.GetId())}
+
+	if err := h.todoUsecase.DeleteShoppingItem(ctx, user, group, in); err != nil {
+		return nil, err
+	}
+
+	return &todoproto.DeleteShoppingItemResponse{}, nil
+}
+
+func (h *todoHandler) ChangeShoppingItemCompletion(ctx context.Context, r *todoproto.ChangeShoppingItemCompletionRequest) (*todoproto.ChangeShoppingItemCompletionResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	group := &input.Group{ID: int(r.GetGroupId())}
+	in := &input.Completion{
+		ID:        int(r.GetId()),
+		Completed: r.GetCompleted(),
+	}
+
+	out, err := h.todoUsecase.ChangeShoppingItemCompletion(ctx, user, group, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &todoproto.ChangeShoppingItemCompletionResponse{
+		ShoppingItem: toShoppingItemProto(out),
+	}, nil
+}
+
+func (h *todoHandler) ListShoppingItems(ctx context.Context, r *todoproto.ListShoppingItemsRequest) (*todoproto.ListShoppingItemsResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	group := &input.Group{ID: int(r.GetGroupId())}
+	in := &input.Period{
+		YearMonth: r.GetYearsMonths(),
+		Date:      r.GetDate(),
+	}
+
+	out, err := h.todoUsecase.ListShoppingItems(ctx, user, group, in)
+	if err != nil {
+		return nil, err
+	}
+
+	shoppingItems := make([]*todoproto.ShoppingItem, len(out.ShoppingItems))
+	for i, shoppingItem := range out.ShoppingItems {
+		shoppingItems[i] = toShoppingItemProto(shoppingItem)
+	}
+
+	return &todoproto.ListShoppingItemsResponse{
+		ShoppingItems: shoppingItems,
+	}, nil
+}
+
+func (h *todoHandler) PurchaseShoppingItem(ctx context.Context, r *todoproto.PurchaseShoppingItemRequest) (*todoproto.PurchaseShoppingItemResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	group := &input.Group{ID: int(r.GetGroupId())}
+	in := &input.Purchase{
+		ID:              int(r.GetId()),
+		Amount:          int(r.GetAmount()),
+		TransactionDate: r.GetTransactionDate(),
+	}
+
+	out, err := h.todoUsecase.PurchaseShoppingItem(ctx, user, group, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &todoproto.PurchaseShoppingItemResponse{
+		ShoppingItem: toShoppingItemProto(out),
+	}, nil
+}
+
+func toTodoProto(todo *output.Todo) *todoproto.Todo {
+	return &todoproto.Todo{
+		Id:                 int64(todo.ID),
+		PostedDate:         todo.PostedDate,
+		UpdatedDate:        todo.UpdatedDate,
+		ImplementationDate: todo.ImplementationDate,
+		DueDate:            todo.DueDate,
+		Content:            todo.Content,
+		Completed:          todo.Completed,
+		PostedUserId:       todo.PostedUserID,
+		AssigneeUserId:     todo.AssigneeUserID,
+	}
+}
+
+func toShoppingItemProto(shoppingItem *output.ShoppingItem) *todoproto.ShoppingItem {
+	return &todoproto.ShoppingItem{
+		Id:                   int64(shoppingItem.ID),
+		PostedDate:           shoppingItem.PostedDate,
+		UpdatedDate:          shoppingItem.UpdatedDate,
+		ExpectedPurchaseDate: shoppingItem.ExpectedPurchaseDate,
+		Name:                 shoppingItem.Name,
+		Shop:                 shoppingItem.Shop,
+		Amount:               int64(shoppingItem.Amount),
+		Completed:            shoppingItem.Completed,
+		PostedUserId:         shoppingItem.PostedUserID,
+		AssigneeUserId:       shoppingItem.AssigneeUserID,
+		BigCategoryId:        int64(shoppingItem.BigCategoryID),
+		BigCategoryName:      shoppingItem.BigCategoryName,
+		MediumCategoryId:     int64(shoppingItem.MediumCategoryID),
+		MediumCategoryName:   shoppingItem.MediumCategoryName,
+		TransactionId:        int64(shoppingItem.TransactionID),
+	}
+}
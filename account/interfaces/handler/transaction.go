@@ -0,0 +1,249 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/paypay3/tukecholl-api/account/usecase"
+	"github.com/paypay3/tukecholl-api/account/usecase/input"
+	"github.com/paypay3/tukecholl-api/account/usecase/output"
+	"github.com/paypay3/tukecholl-api/proto/accountproto"
+)
+
+type transactionHandler struct {
+	transactionUsecase usecase.TransactionUsecase
+	accountproto.UnimplementedTransactionServiceServer
+}
+
+func NewTransactionHandler(transactionUsecase usecase.TransactionUsecase) *transactionHandler {
+	return &transactionHandler{
+		transactionUsecase: transactionUsecase,
+	}
+}
+
+func (h *transactionHandler) PostTransaction(ctx context.Context, r *accountproto.PostTransactionRequest) (*accountproto.PostTransactionResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	in := &input.Transaction{
+		TransactionType:  int(r.GetTransactionType()),
+		TransactionDate:  r.GetTransactionDate(),
+		Shop:             r.GetShop(),
+		Memo:             r.GetMemo(),
+		Amount:           int(r.GetAmount()),
+		BigCategoryID:    int(r.GetBigCategoryId()),
+		MediumCategoryID: int(r.GetMediumCategoryId()),
+		CustomCategoryID: int(r.GetCustomCategoryId()),
+	}
+
+	out, err := h.transactionUsecase.PostTransaction(ctx, user, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountproto.PostTransactionResponse{
+		Transaction: toTransactionProto(out),
+	}, nil
+}
+
+func (h *transactionHandler) EditTransaction(ctx context.Context, r *accountproto.EditTransactionRequest) (*accountproto.EditTransactionResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	in := &input.Transaction{
+		ID:               int(r.GetId()),
+		TransactionType:  int(r.GetTransactionType()),
+		TransactionDate:  r.GetTransactionDate(),
+		Shop:             r.GetShop(),
+		Memo:             r.GetMemo(),
+		Amount:           int(r.GetAmount()),
+		BigCategoryID:    int(r.GetBigCategoryId()),
+		MediumCategoryID: int(r.GetMediumCategoryId()),
+		CustomCategoryID: int(r.GetCustomCategoryId()),
+	}
+
+	out, err := h.transactionUsecase.EditTransaction(ctx, user, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountproto.EditTransactionResponse{
+		Transaction: toTransactionProto(out),
+	}, nil
+}
+
+func (h *transactionHandler) DeleteTransaction(ctx context.Context, r *accountproto.DeleteTransactionRequest) (*accountproto.DeleteTransactionResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	in := &input.Transaction{ID: int(r.GetId())}
+
+	if err := h.transactionUsecase.DeleteTransaction(ctx, user, in); err != nil {
+		return nil, err
+	}
+
+	return &accountproto.DeleteTransactionResponse{}, nil
+}
+
+func (h *transactionHandler) ListTransactions(ctx context.Context, r *accountproto.ListTransactionsRequest) (*accountproto.ListTransactionsResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	in := &input.Transactions{YearMonth: r.GetYearsMonths()}
+
+	out, err := h.transactionUsecase.ListTransactions(ctx, user, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountproto.ListTransactionsResponse{
+		Transactions: toTransactionsProto(out),
+	}, nil
+}
+
+func toTransactionProto(transaction *output.Transaction) *accountproto.Transaction {
+	return &accountproto.Transaction{
+		Id:                 int64(transaction.ID),
+		TransactionType:    toTransactionTypeProto(transaction.TransactionType),
+		PostedDate:         transaction.PostedDate,
+		UpdatedDate:        transaction.UpdatedDate,
+		TransactionDate:    transaction.TransactionDate,
+		Shop:               transaction.Shop,
+		Memo:               transaction.Memo,
+		Amount:             int64(transaction.Amount),
+		BigCategoryId:      int64(transaction.BigCategoryID),
+		BigCategoryName:    transaction.BigCategoryName,
+		MediumCategoryId:   int64(transaction.MediumCategoryID),
+		MediumCategoryName: transaction.MediumCategoryName,
+		CustomCategoryId:   int64(transaction.CustomCategoryID),
+		CustomCategoryName: transaction.CustomCategoryName,
+	}
+}
+
+func toTransactionsProto(out *output.Transactions) []*accountproto.Transaction {
+	transactions := make([]*accountproto.Transaction, len(out.Transactions))
+	for i, transaction := range out.Transactions {
+		transactions[i] = toTransactionProto(transaction)
+	}
+
+	return transactions
+}
+
+func (h *transactionHandler) SearchTransactions(ctx context.Context, r *accountproto.SearchTransactionsRequest) (*accountproto.SearchTransactionsResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	in := &input.SearchTransactions{
+		TransactionType:  int(r.GetTransactionType()),
+		StartDate:        r.GetStartDate(),
+		EndDate:          r.GetEndDate(),
+		BigCategoryID:    int(r.GetBigCategoryId()),
+		MediumCategoryID: int(r.GetMediumCategoryId()),
+		CustomCategoryID: int(r.GetCustomCategoryId()),
+		LowAmount:        int(r.GetLowAmount()),
+		HighAmount:       int(r.GetHighAmount()),
+		Keyword:          r.GetKeyword(),
+		SortField:        int(r.GetSortField()),
+		SortOrder:        int(r.GetSortOrder()),
+		Limit:            int(r.GetLimit()),
+	}
+
+	out, err := h.transactionUsecase.SearchTransactions(ctx, user, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountproto.SearchTransactionsResponse{
+		Transactions: toTransactionsProto(out),
+	}, nil
+}
+
+func (h *transactionHandler) PostGroupTransaction(ctx context.Context, r *accountproto.PostGroupTransactionRequest) (*accountproto.PostGroupTransactionResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	group := &input.Group{ID: int(r.GetGroupId())}
+	in := &input.GroupTransaction{
+		TransactionType:  int(r.GetTransactionType()),
+		TransactionDate:  r.GetTransactionDate(),
+		Shop:             r.GetShop(),
+		Memo:             r.GetMemo(),
+		Amount:           int(r.GetAmount()),
+		PaymentUserID:    r.GetPaymentUserId(),
+		BigCategoryID:    int(r.GetBigCategoryId()),
+		MediumCategoryID: int(r.GetMediumCategoryId()),
+	}
+
+	out, err := h.transactionUsecase.PostGroupTransaction(ctx, user, group, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountproto.PostGroupTransactionResponse{
+		Transaction: toGroupTransactionProto(out),
+	}, nil
+}
+
+func (h *transactionHandler) EditGroupTransaction(ctx context.Context, r *accountproto.EditGroupTransactionRequest) (*accountproto.EditGroupTransactionResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	group := &input.Group{ID: int(r.GetGroupId())}
+	in := &input.GroupTransaction{
+		ID:               int(r.GetId()),
+		TransactionType:  int(r.GetTransactionType()),
+		TransactionDate:  r.GetTransactionDate(),
+		Shop:             r.GetShop(),
+		Memo:             r.GetMemo(),
+		Amount:           int(r.GetAmount()),
+		PaymentUserID:    r.GetPaymentUserId(),
+		BigCategoryID:    int(r.GetBigCategoryId()),
+		MediumCategoryID: int(r.GetMediumCategoryId()),
+	}
+
+	out, err := h.transactionUsecase.EditGroupTransaction(ctx, user, group, in)
+	if err != nil {
+		return nil, err
+	}
+
+	return &accountproto.EditGroupTransactionResponse{
+		Transaction: toGroupTransactionProto(out),
+	}, nil
+}
+
+func (h *transactionHandler) DeleteGroupTransaction(ctx context.Context, r *accountproto.DeleteGroupTransactionRequest) (*accountproto.DeleteGroupTransactionResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	group := &input.Group{ID: int(r.GetGroupId())}
+	in := &input.GroupTransaction{ID: int(r.GetId())}
+
+	if err := h.transactionUsecase.DeleteGroupTransaction(ctx, user, group, in); err != nil {
+		return nil, err
+	}
+
+	return &accountproto.DeleteGroupTransactionResponse{}, nil
+}
+
+func (h *transactionHandler) ListGroupTransactions(ctx context.Context, r *accountproto.ListGroupTransactionsRequest) (*accountproto.ListGroupTransactionsResponse, error) {
+	user := &input.User{ID: r.GetUserId()}
+	group := &input.Group{ID: int(r.GetGroupId())}
+	in := &input.Transactions{YearMonth: r.GetYearsMonths()}
+
+	out, err := h.transactionUsecase.ListGroupTransactions(ctx, user, group, in)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions := make([]*accountproto.GroupTransaction, len(out.Transactions))
+	for i, transaction := range out.Transactions {
+		transactions[i] = toGroupTransactionProto(transaction)
+	}
+
+	return &accountproto.ListGroupTransactionsResponse{
+		Transactions: transactions,
+	}, nil
+}
+
+func toGroupTransactionProto(transaction *output.GroupTransaction) *accountproto.GroupTransaction {
+	return &accountproto.GroupTransaction{
+		Id:                 int64(transaction.ID),
+		TransactionType:    toTransactionTypeProto(transaction.TransactionType),
+		PostedDate:         transaction.PostedDate,
+		UpdatedDate:        transaction.UpdatedDate,
+		TransactionDate:    transaction.TransactionDate,
+		Shop:               transaction.Shop,
+		Memo:               transaction.Memo,
+		Amount:             int64(transaction.Amount),
+		PostedUserId:       transaction.PostedUserID,
+		UpdatedUserId:      transaction.UpdatedUserID,
+		PaymentUserId:      transaction.PaymentUserID,
+		BigCategoryId:      int64(transaction.BigCategoryID),
+		BigCategoryName:    transaction.BigCategoryName,
+		MediumCategoryId:   int64(transaction.MediumCategoryID),
+		MediumCategoryName: transaction.MediumCategoryName,
+	}
+}
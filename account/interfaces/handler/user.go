@@ -0,0 +1,40 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/paypay3/tukecholl-api/account/usecase"
+	"github.com/paypay3/tukecholl-api/account/usecase/input"
+	"github.com/paypay3/tukecholl-api/proto/userproto"
+)
+
+type userHandler struct {
+	userUsecase usecase.UserUsecase
+	userproto.UnimplementedUserServiceServer
+}
+
+func NewUserHandler(userUsecase usecase.UserUsecase) *userHandler {
+	return &userHandler{
+		userUsecase: userUsecase,
+	}
+}
+
+func (h *userHandler) CreateUser(ctx context.Context, r *userproto.CreateUserRequest) (*userproto.CreateUserResponse, error) {
+	user := &input.User{
+		ID:       r.GetId(),
+		Name:     r.GetName(),
+		Email:    r.GetEmail(),
+		Password: r.GetPassword(),
+	}
+
+	out, err := h.userUsecase.CreateUser(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return &userproto.CreateUserResponse{
+		Id:    out.ID,
+		Name:  out.Name,
+		Email: out.Email,
+	}, nil
+}
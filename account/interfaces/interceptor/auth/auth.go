@@ -0,0 +1,60 @@
+// Package auth authenticates the clients by the api keys shared with them.
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"golang.org/x/xerrors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// MetadataKey is the key of the api key in the incoming metadata.
+const MetadataKey = "x-api-key"
+
+// UnaryServerInterceptor rejects the requests without one of the api keys with codes.Unauthenticated.
+func UnaryServerInterceptor(apiKeys []string) (grpc.UnaryServerInterceptor, error) {
+	keys := make([][]byte, 0, len(apiKeys))
+	for _, apiKey := range apiKeys {
+		if apiKey != "" {
+			keys = append(keys, []byte(apiKey))
+		}
+	}
+
+	if len(keys) == 0 {
+		return nil, xerrors.New("auth interceptor requires at least one api key")
+	}
+
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !authenticate(ctx, keys) {
+			return nil, status.Error(codes.Unauthenticated, "invalid api key")
+		}
+
+		return handler(ctx, req)
+	}, nil
+}
+
+func authenticate(ctx context.Context, keys [][]byte) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+
+	values := md.Get(MetadataKey)
+	if len(values) == 0 {
+		return false
+	}
+
+	given := []byte(values[0])
+
+	// every key is compared so that the time taken does not tell which key is close to the given one.
+	matched := 0
+	for _, key := range keys {
+		matched |= subtle.ConstantTimeCompare(given, key)
+	}
+
+	return matched == 1
+}
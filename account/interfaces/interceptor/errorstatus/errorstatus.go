@@ -0,0 +1,121 @@
+// Package errorstatus translates the errors of the usecases into gRPC statuses, which is the only place
+// that knows both apperror and the transport.
+package errorstatus
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/xerrors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/runtime/protoiface"
+
+	"github.com/paypay3/tukecholl-api/account/apperror"
+	"github.com/paypay3/tukecholl-api/account/logger"
+)
+
+// errorDomain is the domain of errdetails.ErrorInfo, which qualifies the reasons of the errors of this service.
+const errorDomain = "account.tukecholl-api"
+
+var errorCodes = map[apperror.Kind]codes.Code{
+	apperror.KindValidation:         codes.InvalidArgument,
+	apperror.KindNotFound:           codes.NotFound,
+	apperror.KindConflict:           codes.AlreadyExists,
+	apperror.KindFailedPrecondition: codes.FailedPrecondition,
+	apperror.KindPermissionDenied:   codes.PermissionDenied,
+	apperror.KindInternal:           codes.Internal,
+}
+
+// UnaryServerInterceptor converts the errors returned by the handlers into gRPC statuses.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, toStatusError(info.FullMethod, err)
+		}
+
+		return resp, nil
+	}
+}
+
+// Code returns the gRPC code which the error is converted into, so that the interceptors inside this one can tell
+// the outcome of the request without converting the error twice.
+func Code(err error) codes.Code {
+	switch {
+	case err == nil:
+		return codes.OK
+	case xerrors.Is(err, context.Canceled):
+		return codes.Canceled
+	case xerrors.Is(err, context.DeadlineExceeded):
+		return codes.DeadlineExceeded
+	}
+
+	if st, ok := status.FromError(err); ok {
+		return st.Code()
+	}
+
+	var appErr *apperror.Error
+	if !xerrors.As(err, &appErr) {
+		return codes.Internal
+	}
+
+	return errorCodes[appErr.Kind()]
+}
+
+// toStatusError converts the error of a usecase into a gRPC status with errdetails.ErrorInfo carrying the stable reason,
+// and errdetails.BadRequest for validation errors.
+// the causes of internal errors and errors of unknown kinds are logged and never sent to clients.
+// errors which are already gRPC statuses, such as the ones of the auth interceptor, are returned as they are.
+func toStatusError(method string, err error) error {
+	switch {
+	case xerrors.Is(err, context.Canceled):
+		return status.Error(codes.Canceled, "request canceled")
+	case xerrors.Is(err, context.DeadlineExceeded):
+		return status.Error(codes.DeadlineExceeded, "deadline exceeded")
+	}
+
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	var appErr *apperror.Error
+	if !xerrors.As(err, &appErr) || appErr.Kind() == apperror.KindInternal {
+		logger.Error("unexpected error", "method", method, "error", fmt.Sprintf("%+v", err))
+
+		appErr = apperror.NewInternalError(err)
+	}
+
+	st := status.New(errorCodes[appErr.Kind()], appErr.Message())
+
+	details := []protoiface.MessageV1{
+		&errdetails.ErrorInfo{
+			Reason: string(appErr.Reason()),
+			Domain: errorDomain,
+		},
+	}
+
+	if violations := appErr.FieldViolations(); len(violations) > 0 {
+		badRequest := &errdetails.BadRequest{
+			FieldViolations: make([]*errdetails.BadRequest_FieldViolation, len(violations)),
+		}
+
+		for i, violation := range violations {
+			badRequest.FieldViolations[i] = &errdetails.BadRequest_FieldViolation{
+				Field:       violation.Field,
+				Description: violation.Description,
+			}
+		}
+
+		details = append(details, badRequest)
+	}
+
+	detailed, err := st.WithDetails(details...)
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
@@ -0,0 +1,60 @@
+// Package logging gives each request a logger carrying the request id, the method and the user id,
+// and logs the result and the latency of the request.
+package logging
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/paypay3/tukecholl-api/account/interfaces/interceptor/errorstatus"
+	"github.com/paypay3/tukecholl-api/account/interfaces/interceptor/requestid"
+	"github.com/paypay3/tukecholl-api/account/logger"
+)
+
+// userIDGetter is implemented by the requests which have the user_id field.
+type userIDGetter interface {
+	GetUserId() string
+}
+
+// UnaryServerInterceptor puts the logger of the request into the context of the handler, which is available with
+// logger.FromContext, and logs a line for each request after the handler returns.
+// the request id is logged only if the requestid interceptor is chained outside this one.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+
+		args := []interface{}{"method", info.FullMethod}
+		if requestID := requestid.FromContext(ctx); requestID != "" {
+			args = append(args, "request_id", requestID)
+		}
+		if r, ok := req.(userIDGetter); ok && r.GetUserId() != "" {
+			args = append(args, "user_id", r.GetUserId())
+		}
+
+		l := logger.FromContext(ctx).With(args...)
+
+		resp, err := handler(logger.NewContext(ctx, l), req)
+
+		code := errorstatus.Code(err)
+		latency := time.Since(start)
+
+		l.Log(levelOf(code), "request completed", "code", code, "latency_ms", float64(latency.Microseconds())/1000)
+
+		return resp, err
+	}
+}
+
+// levelOf logs the failures of the server as errors, and the failures caused by the clients as warnings.
+func levelOf(code codes.Code) logger.Level {
+	switch code {
+	case codes.OK:
+		return logger.LevelInfo
+	case codes.Unknown, codes.Internal, codes.Unavailable, codes.DataLoss, codes.Unimplemented:
+		return logger.LevelError
+	default:
+		return logger.LevelWarn
+	}
+}
@@ -0,0 +1,88 @@
+// Package recovery turns a panic in a handler into an internal error, so that one request cannot bring down the server.
+package recovery
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"sync/atomic"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/paypay3/tukecholl-api/account/interfaces/interceptor/requestid"
+	"github.com/paypay3/tukecholl-api/account/logger"
+)
+
+// redactedKeys are the metadata which must not be written to the logs.
+var redactedKeys = map[string]bool{
+	"authorization": true,
+	"cookie":        true,
+	"x-api-key":     true,
+}
+
+var panicCount uint64
+
+// PanicCount returns the number of the panics recovered since the process started.
+func PanicCount() uint64 {
+	return atomic.LoadUint64(&panicCount)
+}
+
+// UnaryServerInterceptor recovers from a panic in the handler, and logs the panic with the stack and the request metadata
+// with the logger of the request.
+// the client receives codes.Internal without the panic value, which may contain internal details.
+// the request id is logged only if the requestid interceptor is chained outside this one.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				count := atomic.AddUint64(&panicCount, 1)
+
+				logger.FromContext(ctx).Error("panic recovered",
+					"method", info.FullMethod,
+					"request_id", requestid.FromContext(ctx),
+					"peer", peerAddr(ctx),
+					"metadata", redactedMetadata(ctx),
+					"panic_count", count,
+					"panic", fmt.Sprint(r),
+					"stack", string(debug.Stack()),
+				)
+
+				resp, err = nil, status.Error(codes.Internal, "internal error")
+			}
+		}()
+
+		return handler(ctx, req)
+	}
+}
+
+func peerAddr(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "unknown"
+	}
+
+	return p.Addr.String()
+}
+
+// redactedMetadata returns the incoming metadata with the values of redactedKeys masked.
+func redactedMetadata(ctx context.Context) metadata.MD {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return metadata.MD{}
+	}
+
+	redacted := make(metadata.MD, md.Len())
+	for key, values := range md {
+		if redactedKeys[key] {
+			values = []string{"REDACTED"}
+		}
+
+		redacted[key] = values
+	}
+
+	return redacted
+}
@@ -0,0 +1,262 @@
+package recovery
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"strings"
+	"testing"
+
+	"golang.org/x/xerrors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/paypay3/tukecholl-api/account/interfaces/interceptor/requestid"
+	"github.com/paypay3/tukecholl-api/account/logger"
+)
+
+const testMethod = "/account.BudgetService/GetStandardBudgets"
+
+func captureLog(t *testing.T) *bytes.Buffer {
+	t.Helper()
+
+	var buf bytes.Buffer
+	defaultLogger := logger.Default()
+	logger.SetDefault(logger.New(&buf, logger.LevelDebug))
+	t.Cleanup(func() {
+		logger.SetDefault(defaultLogger)
+	})
+
+	return &buf
+}
+
+type panicLog struct {
+	Level      string              `json:"level"`
+	Msg        string              `json:"msg"`
+	Method     string              `json:"method"`
+	RequestID  string              `json:"request_id"`
+	Peer       string              `json:"peer"`
+	Metadata   map[string][]string `json:"metadata"`
+	PanicCount uint64              `json:"panic_count"`
+	Panic      string              `json:"panic"`
+	Stack      string              `json:"stack"`
+}
+
+func decodePanicLog(t *testing.T, buf *bytes.Buffer) *panicLog {
+	t.Helper()
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("len(lines) = %d, want 1:\n%s", len(lines), buf.String())
+	}
+
+	var l panicLog
+	if err := json.Unmarshal([]byte(lines[0]), &l); err != nil {
+		t.Fatalf("log is not JSON: %v\n%s", err, lines[0])
+	}
+
+	return &l
+}
+
+func newContext() context.Context {
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+		"user-agent", "grpc-go/1.37.0",
+		"x-api-key", "secret-key",
+	))
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(192, 0, 2, 1), Port: 50051}})
+
+	return requestid.NewContext(ctx, "req-1")
+}
+
+func TestUnaryServerInterceptorRecoversPanic(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler grpc.UnaryHandler
+		want    string
+	}{
+		{
+			name: "panic with a string",
+			handler: func(ctx context.Context, req interface{}) (interface{}, error) {
+				panic("budget repository is broken")
+			},
+			want: "budget repository is broken",
+		},
+		{
+			name: "panic with an error",
+			handler: func(ctx context.Context, req interface{}) (interface{}, error) {
+				panic(xerrors.New("connection reset"))
+			},
+			want: "connection reset",
+		},
+		{
+			name: "nil pointer dereference",
+			handler: func(ctx context.Context, req interface{}) (interface{}, error) {
+				var m map[string]*int
+				return *m["missing"], nil
+			},
+			want: "invalid memory address or nil pointer dereference",
+		},
+		{
+			name: "index out of range",
+			handler: func(ctx context.Context, req interface{}) (interface{}, error) {
+				var budgets []int
+				return budgets[3], nil
+			},
+			want: "index out of range",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := captureLog(t)
+			before := PanicCount()
+
+			resp, err := UnaryServerInterceptor()(newContext(), nil, &grpc.UnaryServerInfo{FullMethod: testMethod}, tt.handler)
+
+			if resp != nil {
+				t.Errorf("resp = %v, want nil", resp)
+			}
+
+			st, ok := status.FromError(err)
+			if !ok {
+				t.Fatalf("err = %v, want a gRPC status", err)
+			}
+
+			if st.Code() != codes.Internal {
+				t.Errorf("code = %s, want %s", st.Code(), codes.Internal)
+			}
+
+			if strings.Contains(st.Message(), tt.want) {
+				t.Errorf("message = %q, must not contain the panic value", st.Message())
+			}
+
+			if got := PanicCount(); got != before+1 {
+				t.Errorf("PanicCount() = %d, want %d", got, before+1)
+			}
+
+			l := decodePanicLog(t, buf)
+
+			if l.Level != "ERROR" {
+				t.Errorf("level = %s, want ERROR", l.Level)
+			}
+
+			if l.Method != testMethod {
+				t.Errorf("method = %s, want %s", l.Method, testMethod)
+			}
+
+			if l.RequestID != "req-1" {
+				t.Errorf("request_id = %s, want req-1", l.RequestID)
+			}
+
+			if l.Peer != "192.0.2.1:50051" {
+				t.Errorf("peer = %s, want 192.0.2.1:50051", l.Peer)
+			}
+
+			if got := l.Metadata["user-agent"]; len(got) != 1 || got[0] != "grpc-go/1.37.0" {
+				t.Errorf("metadata[user-agent] = %v, want [grpc-go/1.37.0]", got)
+			}
+
+			if got := l.Metadata["x-api-key"]; len(got) != 1 || got[0] != "REDACTED" {
+				t.Errorf("metadata[x-api-key] = %v, want [REDACTED]", got)
+			}
+
+			if l.PanicCount != before+1 {
+				t.Errorf("panic_count = %d, want %d", l.PanicCount, before+1)
+			}
+
+			if !strings.Contains(l.Panic, tt.want) {
+				t.Errorf("panic = %q, want to contain %q", l.Panic, tt.want)
+			}
+
+			if !strings.Contains(l.Stack, "goroutine ") || !strings.Contains(l.Stack, "recovery_test.go") {
+				t.Errorf("stack does not contain the panicking handler:\n%s", l.Stack)
+			}
+
+			if strings.Contains(buf.String(), "secret-key") {
+				t.Errorf("log contains the api key:\n%s", buf.String())
+			}
+		})
+	}
+}
+
+func TestUnaryServerInterceptorPassesThrough(t *testing.T) {
+	errHandler := xerrors.New("handler error")
+
+	tests := []struct {
+		name     string
+		handler  grpc.UnaryHandler
+		wantResp interface{}
+		wantErr  error
+	}{
+		{
+			name: "response",
+			handler: func(ctx context.Context, req interface{}) (interface{}, error) {
+				return "ok", nil
+			},
+			wantResp: "ok",
+		},
+		{
+			name: "error",
+			handler: func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, errHandler
+			},
+			wantErr: errHandler,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := captureLog(t)
+			before := PanicCount()
+
+			resp, err := UnaryServerInterceptor()(newContext(), nil, &grpc.UnaryServerInfo{FullMethod: testMethod}, tt.handler)
+
+			if resp != tt.wantResp {
+				t.Errorf("resp = %v, want %v", resp, tt.wantResp)
+			}
+
+			if err != tt.wantErr {
+				t.Errorf("err = %v, want %v", err, tt.wantErr)
+			}
+
+			if got := PanicCount(); got != before {
+				t.Errorf("PanicCount() = %d, want %d", got, before)
+			}
+
+			if buf.Len() != 0 {
+				t.Errorf("log = %q, want empty", buf.String())
+			}
+		})
+	}
+}
+
+func TestUnaryServerInterceptorWithoutMetadata(t *testing.T) {
+	buf := captureLog(t)
+
+	_, err := UnaryServerInterceptor()(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: testMethod},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			panic("no metadata")
+		})
+
+	if got := status.Code(err); got != codes.Internal {
+		t.Errorf("code = %s, want %s", got, codes.Internal)
+	}
+
+	l := decodePanicLog(t, buf)
+
+	if l.RequestID != "" {
+		t.Errorf("request_id = %s, want empty", l.RequestID)
+	}
+
+	if l.Peer != "unknown" {
+		t.Errorf("peer = %s, want unknown", l.Peer)
+	}
+
+	if len(l.Metadata) != 0 {
+		t.Errorf("metadata = %v, want empty", l.Metadata)
+	}
+}
@@ -0,0 +1,71 @@
+// Package requestid identifies each request by the id given by the client, or a generated one if there is not
+// or it is not a valid request id, so that the logs of a request can be correlated.
+package requestid
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"regexp"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataKey is the key of the request id in both the incoming metadata and the response header.
+const MetadataKey = "x-request-id"
+
+// the request id is written to the logs and the response header as it is,
+// so the one given by the client is bounded to the characters of UUIDs and similar ids.
+var validRequestID = regexp.MustCompile(`^[A-Za-z0-9._\-]{1,64}$`)
+
+type contextKey struct{}
+
+// NewContext returns a copy of the context which carries the request id.
+func NewContext(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, contextKey{}, requestID)
+}
+
+// FromContext returns the request id of the context, or an empty string if the context has no request id.
+func FromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(contextKey{}).(string)
+
+	return requestID
+}
+
+// UnaryServerInterceptor puts the request id into the context of the handler, and sends it back in the response header.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		requestID := fromMetadata(ctx)
+		if requestID == "" {
+			requestID = generate()
+		}
+
+		// the header cannot be sent only if the transport is gone, in which case the request fails anyway.
+		_ = grpc.SetHeader(ctx, metadata.Pairs(MetadataKey, requestID))
+
+		return handler(NewContext(ctx, requestID), req)
+	}
+}
+
+func fromMetadata(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	if values := md.Get(MetadataKey); len(values) > 0 && validRequestID.MatchString(values[0]) {
+		return values[0]
+	}
+
+	return ""
+}
+
+func generate() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return ""
+	}
+
+	return hex.EncodeToString(b)
+}
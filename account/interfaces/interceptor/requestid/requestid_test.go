@@ -0,0 +1,68 @@
+package requestid
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const testMethod = "/account.BudgetService/GetStandardBudgets"
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	tests := []struct {
+		name      string
+		md        metadata.MD
+		wantGiven bool
+	}{
+		{name: "uuid", md: metadata.Pairs(MetadataKey, "0f8fad5b-d9cb-469f-a165-70867728950e"), wantGiven: true},
+		{name: "letters, digits, dots and underscores", md: metadata.Pairs(MetadataKey, "req_01.A"), wantGiven: true},
+		{name: "64 characters", md: metadata.Pairs(MetadataKey, strings.Repeat("a", 64)), wantGiven: true},
+		{name: "65 characters", md: metadata.Pairs(MetadataKey, strings.Repeat("a", 65)), wantGiven: false},
+		{name: "empty", md: metadata.Pairs(MetadataKey, ""), wantGiven: false},
+		{name: "newline", md: metadata.Pairs(MetadataKey, "abc\ndef"), wantGiven: false},
+		{name: "space", md: metadata.Pairs(MetadataKey, "abc def"), wantGiven: false},
+		{name: "non-ascii", md: metadata.Pairs(MetadataKey, "リクエスト"), wantGiven: false},
+		{name: "quote", md: metadata.Pairs(MetadataKey, `abc"def`), wantGiven: false},
+		{name: "no request id", md: metadata.MD{}, wantGiven: false},
+		{name: "no metadata", md: nil, wantGiven: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+
+			var got string
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				got = FromContext(ctx)
+
+				return req, nil
+			}
+
+			if _, err := UnaryServerInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: testMethod}, handler); err != nil {
+				t.Fatalf("error = %v, want nil", err)
+			}
+
+			if tt.wantGiven {
+				if want := tt.md.Get(MetadataKey)[0]; got != want {
+					t.Errorf("FromContext() = %q, want %q", got, want)
+				}
+
+				return
+			}
+
+			if !validRequestID.MatchString(got) {
+				t.Errorf("FromContext() = %q, want a generated request id", got)
+			}
+
+			if values := tt.md.Get(MetadataKey); len(values) > 0 && got == values[0] {
+				t.Errorf("FromContext() = %q, want a generated request id instead of the given one", got)
+			}
+		})
+	}
+}
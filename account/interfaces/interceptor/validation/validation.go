@@ -0,0 +1,89 @@
+// Package validation rejects the requests which address a group or a resource by a non-positive id before they reach
+// the handlers, so that such requests are reported as invalid arguments instead of resources not found.
+package validation
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/paypay3/tukecholl-api/account/apperror"
+)
+
+// positiveIDs lists the identifiers which each method addresses an existing group or resource by.
+// user ids are not listed, because the usecases validate them with vo.NewUserID.
+var positiveIDs = map[string][]protoreflect.Name{
+	"/account.BudgetService/CreateGroupStandardBudgets": {"group_id"},
+	"/account.BudgetService/GetGroupStandardBudgets":    {"group_id"},
+	"/account.BudgetService/EditGroupStandardBudgets":   {"group_id"},
+
+	"/account.CategoryService/EditCustomCategory":   {"id"},
+	"/account.CategoryService/DeleteCustomCategory": {"id"},
+
+	"/account.TransactionService/EditTransaction":        {"id"},
+	"/account.TransactionService/DeleteTransaction":      {"id"},
+	"/account.TransactionService/PostGroupTransaction":   {"group_id"},
+	"/account.TransactionService/EditGroupTransaction":   {"group_id", "id"},
+	"/account.TransactionService/DeleteGroupTransaction": {"group_id", "id"},
+	"/account.TransactionService/ListGroupTransactions":  {"group_id"},
+
+	"/account.RecurringTransactionService/EditRecurringTransaction":   {"id"},
+	"/account.RecurringTransactionService/DeleteRecurringTransaction": {"id"},
+
+	"/group.GroupService/InviteUser":                 {"group_id"},
+	"/group.GroupService/AcceptInvitation":           {"group_id"},
+	"/group.GroupService/DeclineInvitation":          {"group_id"},
+	"/group.GroupService/LeaveGroup":                 {"group_id"},
+	"/group.GroupService/ListMembers":                {"group_id"},
+	"/group.GroupService/GetGroupAccountsSettlement": {"group_id"},
+
+	"/todo.TodoService/CreateTodo":                   {"group_id"},
+	"/todo.TodoService/EditTodo":                     {"group_id", "id"},
+	"/todo.TodoService/DeleteTodo":                   {"group_id", "id"},
+	"/todo.TodoService/ChangeTodoCompletion":         {"group_id", "id"},
+	"/todo.TodoService/ListTodos":                    {"group_id"},
+	"/todo.TodoService/CreateShoppingItem":           {"group_id"},
+	"/todo.TodoService/EditShoppingItem":             {"group_id", "id"},
+	"/todo.TodoService/DeleteShoppingItem":           {"group_id", "id"},
+	"/todo.TodoService/ChangeShoppingItemCompletion": {"group_id", "id"},
+	"/todo.TodoService/ListShoppingItems":            {"group_id"},
+	"/todo.TodoService/PurchaseShoppingItem":         {"group_id", "id"},
+}
+
+// UnaryServerInterceptor validates the identifiers listed in positiveIDs for the method,
+// and returns the violation as an apperror validation error.
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		names, ok := positiveIDs[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		if msg, ok := req.(proto.Message); ok {
+			if err := validatePositiveIDs(msg.ProtoReflect(), names); err != nil {
+				return nil, err
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func validatePositiveIDs(msg protoreflect.Message, names []protoreflect.Name) error {
+	fields := msg.Descriptor().Fields()
+
+	for _, name := range names {
+		fd := fields.ByName(name)
+		if fd == nil || fd.Kind() != protoreflect.Int64Kind {
+			continue
+		}
+
+		if id := msg.Get(fd).Int(); id <= 0 {
+			return apperror.NewValidationError(string(name), "%s must be positive: %d", name, id)
+		}
+	}
+
+	return nil
+}
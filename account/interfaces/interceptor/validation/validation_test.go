@@ -0,0 +1,130 @@
+package validation
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"github.com/paypay3/tukecholl-api/account/apperror"
+	_ "github.com/paypay3/tukecholl-api/proto/accountproto"
+	_ "github.com/paypay3/tukecholl-api/proto/groupproto"
+	"github.com/paypay3/tukecholl-api/proto/todoproto"
+)
+
+// TestPositiveIDsExist guards positiveIDs against renamed methods and fields,
+// which validatePositiveIDs would otherwise skip silently.
+func TestPositiveIDsExist(t *testing.T) {
+	for method, names := range positiveIDs {
+		t.Run(method, func(t *testing.T) {
+			parts := strings.Split(strings.TrimPrefix(method, "/"), "/")
+			if len(parts) != 2 {
+				t.Fatalf("method %q is not in /package.Service/Method format", method)
+			}
+
+			d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(parts[0]))
+			if err != nil {
+				t.Fatalf("FindDescriptorByName(%q) error = %v", parts[0], err)
+			}
+
+			service, ok := d.(protoreflect.ServiceDescriptor)
+			if !ok {
+				t.Fatalf("%s is not a service", parts[0])
+			}
+
+			md := service.Methods().ByName(protoreflect.Name(parts[1]))
+			if md == nil {
+				t.Fatalf("%s has no method %s", parts[0], parts[1])
+			}
+
+			for _, name := range names {
+				fd := md.Input().Fields().ByName(name)
+				if fd == nil {
+					t.Errorf("%s has no field %s", md.Input().FullName(), name)
+					continue
+				}
+
+				if fd.Kind() != protoreflect.Int64Kind || fd.IsList() {
+					t.Errorf("%s.%s is %s, want int64", md.Input().FullName(), name, fd.Kind())
+				}
+			}
+		})
+	}
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	tests := []struct {
+		name        string
+		method      string
+		req         interface{}
+		wantField   string
+		wantHandled bool
+	}{
+		{
+			name:        "positive ids",
+			method:      "/todo.TodoService/EditTodo",
+			req:         &todoproto.EditTodoRequest{GroupId: 1, Id: 1},
+			wantHandled: true,
+		},
+		{
+			name:      "zero group id",
+			method:    "/todo.TodoService/EditTodo",
+			req:       &todoproto.EditTodoRequest{GroupId: 0, Id: 1},
+			wantField: "group_id",
+		},
+		{
+			name:      "negative id",
+			method:    "/todo.TodoService/EditTodo",
+			req:       &todoproto.EditTodoRequest{GroupId: 1, Id: -1},
+			wantField: "id",
+		},
+		{
+			name:        "unlisted method",
+			method:      "/todo.TodoService/Unlisted",
+			req:         &todoproto.EditTodoRequest{},
+			wantHandled: true,
+		},
+		{
+			name:        "request which is not a proto message",
+			method:      "/todo.TodoService/EditTodo",
+			req:         struct{}{},
+			wantHandled: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var handled bool
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				handled = true
+
+				return req, nil
+			}
+
+			_, err := UnaryServerInterceptor()(context.Background(), tt.req, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+			if handled != tt.wantHandled {
+				t.Errorf("handled = %t, want %t", handled, tt.wantHandled)
+			}
+
+			if tt.wantField == "" {
+				if err != nil {
+					t.Errorf("error = %v, want nil", err)
+				}
+
+				return
+			}
+
+			if !apperror.Is(err, apperror.KindValidation) {
+				t.Fatalf("error = %v, want a validation error", err)
+			}
+
+			violations := err.(*apperror.Error).FieldViolations()
+			if len(violations) != 1 || violations[0].Field != tt.wantField {
+				t.Errorf("FieldViolations() = %v, want a violation of %s", violations, tt.wantField)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/paypay3/tukecholl-api/account/logger"
+	"github.com/paypay3/tukecholl-api/account/usecase"
+)
+
+type recurringTransactionScheduler struct {
+	recurringTransactionUsecase usecase.RecurringTransactionUsecase
+	interval                    time.Duration
+}
+
+func NewRecurringTransactionScheduler(recurringTransactionUsecase usecase.RecurringTransactionUsecase, interval time.Duration) *recurringTransactionScheduler {
+	return &recurringTransactionScheduler{
+		recurringTransactionUsecase: recurringTransactionUsecase,
+		interval:                    interval,
+	}
+}
+
+// Run executes the recurring transactions due by today once at start and then every interval, until ctx is done.
+// the execution is idempotent, so it is safe to run on several servers at the same time.
+func (s *recurringTransactionScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.execute(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *recurringTransactionScheduler) execute(ctx context.Context) {
+	// the recurring transactions which did not fail are recorded even if err is not nil.
+	n, err := s.recurringTransactionUsecase.ExecuteAllRecurringTransactions(ctx, time.Now())
+	if err != nil && ctx.Err() == nil {
+		logger.FromContext(ctx).Error("failed to execute recurring transactions", "error", fmt.Sprintf("%+v", err))
+	}
+
+	if n > 0 {
+		logger.FromContext(ctx).Info("recurring transactions executed", "count", n)
+	}
+}
@@ -0,0 +1,255 @@
+// Package logger writes structured logs as JSON lines, such as
+// {"time":"2021-05-01T12:00:00.000000000Z","level":"INFO","msg":"request completed","method":"/user.UserService/GetUser"}.
+//
+// the fields are given as alternating keys and values, like log/slog.
+// the package level functions log with the default logger, and the loggers derived with With are passed around in contexts.
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/xerrors"
+)
+
+type Level int
+
+const (
+	LevelDebug Level = iota - 1
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+func (l Level) String() string {
+	switch l {
+	case LevelDebug:
+		return "DEBUG"
+	case LevelInfo:
+		return "INFO"
+	case LevelWarn:
+		return "WARN"
+	case LevelError:
+		return "ERROR"
+	default:
+		return fmt.Sprintf("LEVEL(%d)", int(l))
+	}
+}
+
+// ParseLevel parses the name of a level case-insensitively, such as "info".
+func ParseLevel(s string) (Level, error) {
+	switch strings.ToLower(s) {
+	case "debug":
+		return LevelDebug, nil
+	case "info":
+		return LevelInfo, nil
+	case "warn", "warning":
+		return LevelWarn, nil
+	case "error":
+		return LevelError, nil
+	default:
+		return 0, xerrors.Errorf("invalid log level: %s", s)
+	}
+}
+
+// badKey is the key of a value which is not preceded by a string key.
+const badKey = "!BADKEY"
+
+type field struct {
+	key   string
+	value interface{}
+}
+
+type Logger struct {
+	// mu is shared by the loggers derived with With, so that the lines written to the same writer do not interleave.
+	mu     *sync.Mutex
+	out    io.Writer
+	level  Level
+	fields []field
+}
+
+// New returns a logger which writes the logs of the level or higher to w.
+func New(w io.Writer, level Level) *Logger {
+	return &Logger{
+		mu:    &sync.Mutex{},
+		out:   w,
+		level: level,
+	}
+}
+
+// With returns a logger which adds the fields to every log.
+// a field replaces the field of the same key which the logger already has.
+func (l *Logger) With(args ...interface{}) *Logger {
+	fields := make([]field, len(l.fields), len(l.fields)+len(args)/2)
+	copy(fields, l.fields)
+
+	return &Logger{
+		mu:     l.mu,
+		out:    l.out,
+		level:  l.level,
+		fields: appendFields(fields, args),
+	}
+}
+
+func (l *Logger) Enabled(level Level) bool {
+	return level >= l.level
+}
+
+func (l *Logger) Debug(msg string, args ...interface{}) {
+	l.Log(LevelDebug, msg, args...)
+}
+
+func (l *Logger) Info(msg string, args ...interface{}) {
+	l.Log(LevelInfo, msg, args...)
+}
+
+func (l *Logger) Warn(msg string, args ...interface{}) {
+	l.Log(LevelWarn, msg, args...)
+}
+
+func (l *Logger) Error(msg string, args ...interface{}) {
+	l.Log(LevelError, msg, args...)
+}
+
+func (l *Logger) Log(level Level, msg string, args ...interface{}) {
+	if !l.Enabled(level) {
+		return
+	}
+
+	fields := make([]field, len(l.fields), len(l.fields)+3+len(args)/2)
+	copy(fields, l.fields)
+	fields = appendFields(fields, args)
+
+	var buf bytes.Buffer
+	buf.WriteString(`{"time":`)
+	writeValue(&buf, time.Now().UTC().Format(time.RFC3339Nano))
+	buf.WriteString(`,"level":`)
+	writeValue(&buf, level.String())
+	buf.WriteString(`,"msg":`)
+	writeValue(&buf, msg)
+
+	for _, f := range fields {
+		buf.WriteByte(',')
+		writeValue(&buf, f.key)
+		buf.WriteByte(':')
+		writeValue(&buf, f.value)
+	}
+	buf.WriteString("}\n")
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// a logger has nowhere to report its own write errors.
+	_, _ = l.out.Write(buf.Bytes())
+}
+
+// appendFields appends the alternating keys and values to fields, replacing the values of the keys already in fields
+// except badKey, which is kept for every misplaced value.
+// time, level and msg are reserved for every log, so the fields of these keys are prefixed with "field.".
+func appendFields(fields []field, args []interface{}) []field {
+	for len(args) > 0 {
+		var f field
+
+		key, ok := args[0].(string)
+		if !ok || len(args) == 1 {
+			f, args = field{key: badKey, value: args[0]}, args[1:]
+		} else {
+			f, args = field{key: key, value: args[1]}, args[2:]
+		}
+
+		switch f.key {
+		case "time", "level", "msg":
+			f.key = "field." + f.key
+		}
+
+		replaced := false
+		for i := range fields {
+			if f.key != badKey && fields[i].key == f.key {
+				fields[i].value = f.value
+				replaced = true
+				break
+			}
+		}
+
+		if !replaced {
+			fields = append(fields, f)
+		}
+	}
+
+	return fields
+}
+
+// writeValue writes v as JSON. errors, durations and fmt.Stringers are written as their strings,
+// and the values which cannot be marshaled are written as formatted by fmt.
+func writeValue(buf *bytes.Buffer, v interface{}) {
+	switch x := v.(type) {
+	case error:
+		v = x.Error()
+	case time.Duration:
+		v = x.String()
+	case fmt.Stringer:
+		v = x.String()
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		b, _ = json.Marshal(fmt.Sprint(v))
+	}
+
+	buf.Write(b)
+}
+
+var defaultLogger atomic.Value
+
+func init() {
+	defaultLogger.Store(New(os.Stderr, LevelInfo))
+}
+
+// Default returns the default logger, which writes the logs of LevelInfo or higher to os.Stderr until SetDefault is called.
+func Default() *Logger {
+	return defaultLogger.Load().(*Logger)
+}
+
+func SetDefault(l *Logger) {
+	defaultLogger.Store(l)
+}
+
+func Debug(msg string, args ...interface{}) {
+	Default().Log(LevelDebug, msg, args...)
+}
+
+func Info(msg string, args ...interface{}) {
+	Default().Log(LevelInfo, msg, args...)
+}
+
+func Warn(msg string, args ...interface{}) {
+	Default().Log(LevelWarn, msg, args...)
+}
+
+func Error(msg string, args ...interface{}) {
+	Default().Log(LevelError, msg, args...)
+}
+
+type contextKey struct{}
+
+// NewContext returns a copy of the context which carries the logger, such as the logger of a request.
+func NewContext(ctx context.Context, l *Logger) context.Context {
+	return context.WithValue(ctx, contextKey{}, l)
+}
+
+// FromContext returns the logger carried by the context, or the default logger if there is none.
+func FromContext(ctx context.Context) *Logger {
+	if l, ok := ctx.Value(contextKey{}).(*Logger); ok {
+		return l
+	}
+
+	return Default()
+}
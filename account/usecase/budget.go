@@ -1,37 +1,620 @@
 package usecase
 
 import (
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
+	"context"
+	"fmt"
 
+	"github.com/paypay3/tukecholl-api/account/apperror"
 	"github.com/paypay3/tukecholl-api/account/domain/budgetdomain"
+	"github.com/paypay3/tukecholl-api/account/domain/categorydomain"
+	"github.com/paypay3/tukecholl-api/account/domain/groupdomain"
+	"github.com/paypay3/tukecholl-api/account/domain/transactiondomain"
 	"github.com/paypay3/tukecholl-api/account/domain/vo"
 	"github.com/paypay3/tukecholl-api/account/usecase/input"
+	"github.com/paypay3/tukecholl-api/account/usecase/output"
 )
 
 type BudgetUsecase interface {
-	CreateStandardBudgets(user *input.User) error
+	CreateStandardBudgets(ctx context.Context, user *input.User) error
+	GetStandardBudgets(ctx context.Context, user *input.User) (*output.StandardBudgets, error)
+	EditStandardBudgets(ctx context.Context, user *input.User, in *input.StandardBudgets) (*output.StandardBudgets, error)
+	CreateCustomBudgets(ctx context.Context, user *input.User, in *input.CustomBudgets) (*output.CustomBudgets, error)
+	GetCustomBudgets(ctx context.Context, user *input.User, in *input.CustomBudgets) (*output.CustomBudgets, error)
+	EditCustomBudgets(ctx context.Context, user *input.User, in *input.CustomBudgets) (*output.CustomBudgets, error)
+	DeleteCustomBudgets(ctx context.Context, user *input.User, in *input.CustomBudgets) error
+	GetYearlyBudget(ctx context.Context, user *input.User, in *input.YearlyBudget) (*output.YearlyBudget, error)
+	GetBudgetStatus(ctx context.Context, user *input.User, in *input.BudgetStatus) (*output.BudgetStatus, error)
+	CreateGroupStandardBudgets(ctx context.Context, user *input.User, group *input.Group) error
+	GetGroupStandardBudgets(ctx context.Context, user *input.User, group *input.Group) (*output.StandardBudgets, error)
+	EditGroupStandardBudgets(ctx context.Context, user *input.User, group *input.Group, in *input.StandardBudgets) (*output.StandardBudgets, error)
 }
 
 type budgetUsecase struct {
-	budgetRepository budgetdomain.Repository
+	transactionManager    TransactionManager
+	budgetRepository      budgetdomain.Repository
+	categoryRepository    categorydomain.Repository
+	transactionRepository transactiondomain.Repository
+	groupRepository       groupdomain.Repository
 }
 
-func NewBudgetUsecase(budgetRepository budgetdomain.Repository) *budgetUsecase {
+func NewBudgetUsecase(
+	transactionManager TransactionManager,
+	budgetRepository budgetdomain.Repository,
+	categoryRepository categorydomain.Repository,
+	transactionRepository transactiondomain.Repository,
+	groupRepository groupdomain.Repository,
+) *budgetUsecase {
 	return &budgetUsecase{
-		budgetRepository: budgetRepository,
+		transactionManager:    transactionManager,
+		budgetRepository:      budgetRepository,
+		categoryRepository:    categoryRepository,
+		transactionRepository: transactionRepository,
+		groupRepository:       groupRepository,
 	}
 }
 
-func (u *budgetUsecase) CreateStandardBudgets(user *input.User) error {
+func (u *budgetUsecase) CreateStandardBudgets(ctx context.Context, user *input.User) error {
 	userID, err := vo.NewUserID(user.ID)
 	if err != nil {
-		return status.Errorf(codes.InvalidArgument, "invalid user id: %v", err)
+		return apperror.NewFieldError("user_id", err)
 	}
 
-	if err := u.budgetRepository.CreateStandardBudgets(userID); err != nil {
+	bigCategoryIDs, err := getBudgetableBigCategoryIDs(ctx, u.categoryRepository)
+	if err != nil {
+		return err
+	}
+
+	if err := u.budgetRepository.CreateStandardBudgets(ctx, userID, bigCategoryIDs); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (u *budgetUsecase) GetStandardBudgets(ctx context.Context, user *input.User) (*output.StandardBudgets, error) {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return nil, apperror.NewFieldError("user_id", err)
+	}
+
+	standardBudgets, err := u.budgetRepository.GetStandardBudgets(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toStandardBudgetsOutput(standardBudgets), nil
+}
+
+func (u *budgetUsecase) EditStandardBudgets(ctx context.Context, user *input.User, in *input.StandardBudgets) (*output.StandardBudgets, error) {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return nil, apperror.NewFieldError("user_id", err)
+	}
+
+	currentStandardBudgets, err := u.budgetRepository.GetStandardBudgets(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	validator := newBudgetValidator(currentStandardBudgets)
+	standardBudgets := make([]*budgetdomain.StandardBudget, len(in.StandardBudgets))
+	for i, standardBudget := range in.StandardBudgets {
+		bigCategoryID, bigCategoryName, budget, err := validator.validate("standard_budgets", i, standardBudget.BigCategoryID, standardBudget.Budget)
+		if err != nil {
+			return nil, err
+		}
+
+		standardBudgets[i] = budgetdomain.NewStandardBudget(bigCategoryID, bigCategoryName, budget)
+	}
+
+	if err := u.transactionManager.Transaction(ctx, func(ctx context.Context) error {
+		return u.budgetRepository.EditStandardBudgets(ctx, userID, standardBudgets)
+	}); err != nil {
+		return nil, err
+	}
+
+	updatedStandardBudgets, err := u.budgetRepository.GetStandardBudgets(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toStandardBudgetsOutput(updatedStandardBudgets), nil
+}
+
+func (u *budgetUsecase) CreateCustomBudgets(ctx context.Context, user *input.User, in *input.CustomBudgets) (*output.CustomBudgets, error) {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return nil, apperror.NewFieldError("user_id", err)
+	}
+
+	yearMonth, err := vo.NewYearMonth(in.YearMonth)
+	if err != nil {
+		return nil, apperror.NewFieldError("years_months", err)
+	}
+
+	standardBudgets, err := u.budgetRepository.GetStandardBudgets(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := u.budgetRepository.GetCustomBudgets(ctx, userID, yearMonth); err == nil {
+		return nil, apperror.NewConflictError(apperror.ReasonCustomBudgetsAlreadyExist, "custom budgets already exist: %s %s", userID, yearMonth)
+	} else if !apperror.Is(err, apperror.KindNotFound) {
+		return nil, err
+	}
+
+	validator := newBudgetValidator(standardBudgets)
+	budgets := make(map[vo.BigCategoryID]vo.BudgetAmount, len(in.CustomBudgets))
+	for i, customBudget := range in.CustomBudgets {
+		bigCategoryID, _, budget, err := validator.validate("custom_budgets", i, customBudget.BigCategoryID, customBudget.Budget)
+		if err != nil {
+			return nil, err
+		}
+
+		budgets[bigCategoryID] = budget
+	}
+
+	// big categories not specified in the request inherit the amount of the standard budget.
+	customBudgets := make([]*budgetdomain.CustomBudget, len(standardBudgets))
+	for i, standardBudget := range standardBudgets {
+		budget, ok := budgets[standardBudget.BigCategoryID()]
+		if !ok {
+			budget = standardBudget.Budget()
+		}
+
+		customBudgets[i] = budgetdomain.NewCustomBudget(standardBudget.BigCategoryID(), standardBudget.BigCategoryName(), budget)
+	}
+
+	if err := u.budgetRepository.CreateCustomBudgets(ctx, userID, yearMonth, customBudgets); err != nil {
+		return nil, err
+	}
+
+	return toCustomBudgetsOutput(yearMonth, customBudgets), nil
+}
+
+func (u *budgetUsecase) GetCustomBudgets(ctx context.Context, user *input.User, in *input.CustomBudgets) (*output.CustomBudgets, error) {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return nil, apperror.NewFieldError("user_id", err)
+	}
+
+	yearMonth, err := vo.NewYearMonth(in.YearMonth)
+	if err != nil {
+		return nil, apperror.NewFieldError("years_months", err)
+	}
+
+	customBudgets, err := u.budgetRepository.GetCustomBudgets(ctx, userID, yearMonth)
+	if err == nil {
+		return toCustomBudgetsOutput(yearMonth, customBudgets), nil
+	}
+
+	if !apperror.Is(err, apperror.KindNotFound) {
+		return nil, err
+	}
+
+	standardBudgets, err := u.budgetRepository.GetStandardBudgets(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &output.CustomBudgets{
+		YearMonth:     yearMonth.String(),
+		BudgetType:    output.BudgetTypeStandard,
+		CustomBudgets: make([]*output.CustomBudget, len(standardBudgets)),
+	}
+
+	for i, standardBudget := range standardBudgets {
+		out.CustomBudgets[i] = &output.CustomBudget{
+			BigCategoryID:   standardBudget.BigCategoryID().Value(),
+			BigCategoryName: standardBudget.BigCategoryName(),
+			Budget:          standardBudget.Budget().Value(),
+		}
+	}
+
+	return out, nil
+}
+
+func (u *budgetUsecase) EditCustomBudgets(ctx context.Context, user *input.User, in *input.CustomBudgets) (*output.CustomBudgets, error) {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return nil, apperror.NewFieldError("user_id", err)
+	}
+
+	yearMonth, err := vo.NewYearMonth(in.YearMonth)
+	if err != nil {
+		return nil, apperror.NewFieldError("years_months", err)
+	}
+
+	if _, err := u.budgetRepository.GetCustomBudgets(ctx, userID, yearMonth); err != nil {
+		return nil, err
+	}
+
+	standardBudgets, err := u.budgetRepository.GetStandardBudgets(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	validator := newBudgetValidator(standardBudgets)
+	customBudgets := make([]*budgetdomain.CustomBudget, len(in.CustomBudgets))
+	for i, customBudget := range in.CustomBudgets {
+		bigCategoryID, bigCategoryName, budget, err := validator.validate("custom_budgets", i, customBudget.BigCategoryID, customBudget.Budget)
+		if err != nil {
+			return nil, err
+		}
+
+		customBudgets[i] = budgetdomain.NewCustomBudget(bigCategoryID, bigCategoryName, budget)
+	}
+
+	if err := u.transactionManager.Transaction(ctx, func(ctx context.Context) error {
+		return u.budgetRepository.EditCustomBudgets(ctx, userID, yearMonth, customBudgets)
+	}); err != nil {
+		return nil, err
+	}
+
+	updatedCustomBudgets, err := u.budgetRepository.GetCustomBudgets(ctx, userID, yearMonth)
+	if err != nil {
+		return nil, err
+	}
+
+	return toCustomBudgetsOutput(yearMonth, updatedCustomBudgets), nil
+}
+
+func (u *budgetUsecase) DeleteCustomBudgets(ctx context.Context, user *input.User, in *input.CustomBudgets) error {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return apperror.NewFieldError("user_id", err)
+	}
+
+	yearMonth, err := vo.NewYearMonth(in.YearMonth)
+	if err != nil {
+		return apperror.NewFieldError("years_months", err)
+	}
+
+	if err := u.budgetRepository.DeleteCustomBudgets(ctx, userID, yearMonth); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (u *budgetUsecase) GetYearlyBudget(ctx context.Context, user *input.User, in *input.YearlyBudget) (*output.YearlyBudget, error) {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return nil, apperror.NewFieldError("user_id", err)
+	}
+
+	january, err := vo.NewYearMonth(fmt.Sprintf("%d-01", in.Year))
+	if err != nil {
+		return nil, apperror.NewValidationError("year", "invalid year: %d", in.Year)
+	}
+
+	yearMonths := make([]vo.YearMonth, 12)
+	for i, yearMonth := 0, january; i < len(yearMonths); i, yearMonth = i+1, yearMonth.Next() {
+		yearMonths[i] = yearMonth
+	}
+
+	standardBudgets, err := u.budgetRepository.GetStandardBudgets(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	monthlyCustomBudgetsList, err := u.budgetRepository.GetMonthlyCustomBudgetsList(ctx, userID, yearMonths[0], yearMonths[len(yearMonths)-1])
+	if err != nil {
+		return nil, err
+	}
+
+	customBudgetsByMonth := make(map[string][]*budgetdomain.CustomBudget, len(monthlyCustomBudgetsList))
+	for _, monthlyCustomBudgets := range monthlyCustomBudgetsList {
+		customBudgetsByMonth[monthlyCustomBudgets.YearMonth().String()] = monthlyCustomBudgets.CustomBudgets()
+	}
+
+	out := &output.YearlyBudget{
+		Year:               in.Year,
+		BigCategoryBudgets: make([]*output.BigCategoryBudget, len(standardBudgets)),
+		MonthlyBudgets:     make([]*output.MonthlyBudget, len(yearMonths)),
+	}
+
+	yearlyBigCategoryBudgets := make(map[int]*output.BigCategoryBudget, len(standardBudgets))
+	for i, standardBudget := range standardBudgets {
+		out.BigCategoryBudgets[i] = &output.BigCategoryBudget{
+			BigCategoryID:   standardBudget.BigCategoryID().Value(),
+			BigCategoryName: standardBudget.BigCategoryName(),
+		}
+		yearlyBigCategoryBudgets[standardBudget.BigCategoryID().Value()] = out.BigCategoryBudgets[i]
+	}
+
+	for i, yearMonth := range yearMonths {
+		monthlyBudget := &output.MonthlyBudget{
+			YearMonth: yearMonth.String(),
+		}
+
+		if customBudgets, ok := customBudgetsByMonth[yearMonth.String()]; ok {
+			monthlyBudget.BudgetType = output.BudgetTypeCustom
+			for _, customBudget := range customBudgets {
+				monthlyBudget.BigCategoryBudgets = append(monthlyBudget.BigCategoryBudgets, &output.BigCategoryBudget{
+					BigCategoryID:   customBudget.BigCategoryID().Value(),
+					BigCategoryName: customBudget.BigCategoryName(),
+					Budget:          customBudget.Budget().Value(),
+				})
+			}
+		} else {
+			monthlyBudget.BudgetType = output.BudgetTypeStandard
+			for _, standardBudget := range standardBudgets {
+				monthlyBudget.BigCategoryBudgets = append(monthlyBudget.BigCategoryBudgets, &output.BigCategoryBudget{
+					BigCategoryID:   standardBudget.BigCategoryID().Value(),
+					BigCategoryName: standardBudget.BigCategoryName(),
+					Budget:          standardBudget.Budget().Value(),
+				})
+			}
+		}
+
+		for _, bigCategoryBudget := range monthlyBudget.BigCategoryBudgets {
+			monthlyBudget.TotalBudget += bigCategoryBudget.Budget
+
+			if yearlyBigCategoryBudget, ok := yearlyBigCategoryBudgets[bigCategoryBudget.BigCategoryID]; ok {
+				yearlyBigCategoryBudget.Budget += bigCategoryBudget.Budget
+			}
+		}
+
+		out.TotalBudget += monthlyBudget.TotalBudget
+		out.MonthlyBudgets[i] = monthlyBudget
+	}
+
+	return out, nil
+}
+
+func (u *budgetUsecase) GetBudgetStatus(ctx context.Context, user *input.User, in *input.BudgetStatus) (*output.BudgetStatus, error) {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return nil, apperror.NewFieldError("user_id", err)
+	}
+
+	yearMonth, err := vo.NewYearMonth(in.YearMonth)
+	if err != nil {
+		return nil, apperror.NewFieldError("years_months", err)
+	}
+
+	monthlyBudget, err := u.getMonthlyBudget(ctx, userID, yearMonth)
+	if err != nil {
+		return nil, err
+	}
+
+	bigCategoryTotals, err := u.transactionRepository.GetMonthlyBigCategoryTotals(ctx, userID, yearMonth, vo.TransactionTypeExpense)
+	if err != nil {
+		return nil, err
+	}
+
+	expenses := make(map[int]int, len(bigCategoryTotals))
+	for _, bigCategoryTotal := range bigCategoryTotals {
+		expenses[bigCategoryTotal.BigCategoryID().Value()] = bigCategoryTotal.TotalAmount()
+	}
+
+	out := &output.BudgetStatus{
+		YearMonth:                 monthlyBudget.YearMonth,
+		BudgetType:                monthlyBudget.BudgetType,
+		BigCategoryBudgetStatuses: make([]*output.BigCategoryBudgetStatus, len(monthlyBudget.BigCategoryBudgets)),
+	}
+
+	for i, bigCategoryBudget := range monthlyBudget.BigCategoryBudgets {
+		expense := expenses[bigCategoryBudget.BigCategoryID]
+
+		out.BigCategoryBudgetStatuses[i] = &output.BigCategoryBudgetStatus{
+			BigCategoryID:   bigCategoryBudget.BigCategoryID,
+			BigCategoryName: bigCategoryBudget.BigCategoryName,
+			Budget:          bigCategoryBudget.Budget,
+			Expense:         expense,
+			Remaining:       bigCategoryBudget.Budget - expense,
+		}
+
+		out.TotalBudget += bigCategoryBudget.Budget
+		out.TotalExpense += expense
+	}
+	out.TotalRemaining = out.TotalBudget - out.TotalExpense
+
+	return out, nil
+}
+
+// getMonthlyBudget returns the custom budgets of the month, or the standard budgets if there are none.
+func (u *budgetUsecase) getMonthlyBudget(ctx context.Context, userID vo.UserID, yearMonth vo.YearMonth) (*output.MonthlyBudget, error) {
+	monthlyBudget := &output.MonthlyBudget{
+		YearMonth: yearMonth.String(),
+	}
+
+	customBudgets, err := u.budgetRepository.GetCustomBudgets(ctx, userID, yearMonth)
+	if err == nil {
+		monthlyBudget.BudgetType = output.BudgetTypeCustom
+		for _, customBudget := range customBudgets {
+			monthlyBudget.BigCategoryBudgets = append(monthlyBudget.BigCategoryBudgets, &output.BigCategoryBudget{
+				BigCategoryID:   customBudget.BigCategoryID().Value(),
+				BigCategoryName: customBudget.BigCategoryName(),
+				Budget:          customBudget.Budget().Value(),
+			})
+			monthlyBudget.TotalBudget += customBudget.Budget().Value()
+		}
+
+		return monthlyBudget, nil
+	}
+
+	if !apperror.Is(err, apperror.KindNotFound) {
+		return nil, err
+	}
+
+	standardBudgets, err := u.budgetRepository.GetStandardBudgets(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	monthlyBudget.BudgetType = output.BudgetTypeStandard
+	for _, standardBudget := range standardBudgets {
+		monthlyBudget.BigCategoryBudgets = append(monthlyBudget.BigCategoryBudgets, &output.BigCategoryBudget{
+			BigCategoryID:   standardBudget.BigCategoryID().Value(),
+			BigCategoryName: standardBudget.BigCategoryName(),
+			Budget:          standardBudget.Budget().Value(),
+		})
+		monthlyBudget.TotalBudget += standardBudget.Budget().Value()
+	}
+
+	return monthlyBudget, nil
+}
+
+func (u *budgetUsecase) CreateGroupStandardBudgets(ctx context.Context, user *input.User, group *input.Group) error {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return apperror.NewFieldError("user_id", err)
+	}
+
+	if err := checkGroupMember(ctx, u.groupRepository, group.ID, userID); err != nil {
+		return err
+	}
+
+	bigCategoryIDs, err := getBudgetableBigCategoryIDs(ctx, u.categoryRepository)
+	if err != nil {
+		return err
+	}
+
+	if err := u.budgetRepository.CreateGroupStandardBudgets(ctx, group.ID, bigCategoryIDs); err != nil {
 		return err
 	}
 
 	return nil
 }
+
+func (u *budgetUsecase) GetGroupStandardBudgets(ctx context.Context, user *input.User, group *input.Group) (*output.StandardBudgets, error) {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return nil, apperror.NewFieldError("user_id", err)
+	}
+
+	if err := checkGroupMember(ctx, u.groupRepository, group.ID, userID); err != nil {
+		return nil, err
+	}
+
+	standardBudgets, err := u.budgetRepository.GetGroupStandardBudgets(ctx, group.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toStandardBudgetsOutput(standardBudgets), nil
+}
+
+func (u *budgetUsecase) EditGroupStandardBudgets(ctx context.Context, user *input.User, group *input.Group, in *input.StandardBudgets) (*output.StandardBudgets, error) {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return nil, apperror.NewFieldError("user_id", err)
+	}
+
+	if err := checkGroupMember(ctx, u.groupRepository, group.ID, userID); err != nil {
+		return nil, err
+	}
+
+	currentStandardBudgets, err := u.budgetRepository.GetGroupStandardBudgets(ctx, group.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	validator := newBudgetValidator(currentStandardBudgets)
+	standardBudgets := make([]*budgetdomain.StandardBudget, len(in.StandardBudgets))
+	for i, standardBudget := range in.StandardBudgets {
+		bigCategoryID, bigCategoryName, budget, err := validator.validate("standard_budgets", i, standardBudget.BigCategoryID, standardBudget.Budget)
+		if err != nil {
+			return nil, err
+		}
+
+		standardBudgets[i] = budgetdomain.NewStandardBudget(bigCategoryID, bigCategoryName, budget)
+	}
+
+	if err := u.transactionManager.Transaction(ctx, func(ctx context.Context) error {
+		return u.budgetRepository.EditGroupStandardBudgets(ctx, group.ID, standardBudgets)
+	}); err != nil {
+		return nil, err
+	}
+
+	updatedStandardBudgets, err := u.budgetRepository.GetGroupStandardBudgets(ctx, group.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toStandardBudgetsOutput(updatedStandardBudgets), nil
+}
+
+// budgetValidator validates the budgets requested for each big category.
+// only the big categories the user has standard budgets for can be budgeted, and each of them at most once.
+type budgetValidator struct {
+	bigCategoryNames        map[vo.BigCategoryID]string
+	validatedBigCategoryIDs map[vo.BigCategoryID]struct{}
+}
+
+func newBudgetValidator(standardBudgets []*budgetdomain.StandardBudget) *budgetValidator {
+	bigCategoryNames := make(map[vo.BigCategoryID]string, len(standardBudgets))
+	for _, standardBudget := range standardBudgets {
+		bigCategoryNames[standardBudget.BigCategoryID()] = standardBudget.BigCategoryName()
+	}
+
+	return &budgetValidator{
+		bigCategoryNames:        bigCategoryNames,
+		validatedBigCategoryIDs: make(map[vo.BigCategoryID]struct{}, len(standardBudgets)),
+	}
+}
+
+// validate reports the violations on the path of the budget in the request, such as "standard_budgets[3].budget",
+// where field is the name of the repeated field and index is the position of the budget in it.
+func (v *budgetValidator) validate(field string, index, id, budget int) (vo.BigCategoryID, string, vo.BudgetAmount, error) {
+	bigCategoryIDPath := fmt.Sprintf("%s[%d].big_category_id", field, index)
+	budgetPath := fmt.Sprintf("%s[%d].budget", field, index)
+
+	bigCategoryID, err := vo.NewBigCategoryID(id)
+	if err != nil {
+		return 0, "", 0, apperror.NewFieldError(bigCategoryIDPath, err)
+	}
+
+	bigCategoryName, ok := v.bigCategoryNames[bigCategoryID]
+	if !ok {
+		return 0, "", 0, apperror.NewValidationError(bigCategoryIDPath, "invalid big category id: %d", bigCategoryID)
+	}
+
+	if _, ok := v.validatedBigCategoryIDs[bigCategoryID]; ok {
+		return 0, "", 0, apperror.NewValidationError(bigCategoryIDPath, "duplicate big category id: %d", bigCategoryID)
+	}
+	v.validatedBigCategoryIDs[bigCategoryID] = struct{}{}
+
+	budgetAmount, err := vo.NewBudgetAmount(budget)
+	if err != nil {
+		return 0, "", 0, apperror.NewFieldError(budgetPath, err)
+	}
+
+	return bigCategoryID, bigCategoryName, budgetAmount, nil
+}
+
+func toStandardBudgetsOutput(standardBudgets []*budgetdomain.StandardBudget) *output.StandardBudgets {
+	out := &output.StandardBudgets{
+		StandardBudgets: make([]*output.StandardBudget, len(standardBudgets)),
+	}
+
+	for i, standardBudget := range standardBudgets {
+		out.StandardBudgets[i] = &output.StandardBudget{
+			BigCategoryID:   standardBudget.BigCategoryID().Value(),
+			BigCategoryName: standardBudget.BigCategoryName(),
+			Budget:          standardBudget.Budget().Value(),
+		}
+	}
+
+	return out
+}
+
+func toCustomBudgetsOutput(yearMonth vo.YearMonth, customBudgets []*budgetdomain.CustomBudget) *output.CustomBudgets {
+	out := &output.CustomBudgets{
+		YearMonth:     yearMonth.String(),
+		BudgetType:    output.BudgetTypeCustom,
+		CustomBudgets: make([]*output.CustomBudget, len(customBudgets)),
+	}
+
+	for i, customBudget := range customBudgets {
+		out.CustomBudgets[i] = &output.CustomBudget{
+			BigCategoryID:   customBudget.BigCategoryID().Value(),
+			BigCategoryName: customBudget.BigCategoryName(),
+			Budget:          customBudget.Budget().Value(),
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,259 @@
+package usecase
+
+import (
+	"context"
+
+	"golang.org/x/xerrors"
+
+	"github.com/paypay3/tukecholl-api/account/apperror"
+	"github.com/paypay3/tukecholl-api/account/domain/categorydomain"
+	"github.com/paypay3/tukecholl-api/account/domain/vo"
+	"github.com/paypay3/tukecholl-api/account/usecase/input"
+	"github.com/paypay3/tukecholl-api/account/usecase/output"
+)
+
+type CategoryUsecase interface {
+	ListCategories(ctx context.Context, user *input.User, in *input.Categories) (*output.Categories, error)
+	CreateCustomCategory(ctx context.Context, user *input.User, in *input.CustomCategory) (*output.CustomCategory, error)
+	EditCustomCategory(ctx context.Context, user *input.User, in *input.CustomCategory) (*output.CustomCategory, error)
+	DeleteCustomCategory(ctx context.Context, user *input.User, in *input.CustomCategory) error
+}
+
+type categoryUsecase struct {
+	categoryRepository categorydomain.Repository
+}
+
+func NewCategoryUsecase(categoryRepository categorydomain.Repository) *categoryUsecase {
+	return &categoryUsecase{
+		categoryRepository: categoryRepository,
+	}
+}
+
+// ListCategories lists the categories of the given transaction type, or all of them if the type is unspecified.
+func (u *categoryUsecase) ListCategories(ctx context.Context, user *input.User, in *input.Categories) (*output.Categories, error) {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return nil, apperror.NewFieldError("user_id", err)
+	}
+
+	var transactionType vo.TransactionType
+	if in.TransactionType != 0 {
+		t, err := vo.NewTransactionType(in.TransactionType)
+		if err != nil {
+			return nil, apperror.NewFieldError("transaction_type", err)
+		}
+
+		transactionType = t
+	}
+
+	bigCategories, err := u.categoryRepository.GetBigCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mediumCategories, err := u.categoryRepository.GetMediumCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	customCategories, err := u.categoryRepository.GetCustomCategories(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &output.Categories{
+		BigCategories: make([]*output.BigCategory, 0, len(bigCategories)),
+	}
+
+	outBigCategories := make(map[vo.BigCategoryID]*output.BigCategory, len(bigCategories))
+	for _, bigCategory := range bigCategories {
+		if transactionType != 0 && bigCategory.TransactionType() != transactionType {
+			continue
+		}
+
+		outBigCategory := &output.BigCategory{
+			ID:               bigCategory.ID().Value(),
+			Name:             bigCategory.Name(),
+			TransactionType:  output.TransactionType(bigCategory.TransactionType().Value()),
+			MediumCategories: make([]*output.MediumCategory, 0),
+			CustomCategories: make([]*output.CustomCategory, 0),
+		}
+
+		out.BigCategories = append(out.BigCategories, outBigCategory)
+		outBigCategories[bigCategory.ID()] = outBigCategory
+	}
+
+	for _, mediumCategory := range mediumCategories {
+		if outBigCategory, ok := outBigCategories[mediumCategory.BigCategoryID()]; ok {
+			outBigCategory.MediumCategories = append(outBigCategory.MediumCategories, &output.MediumCategory{
+				ID:            mediumCategory.ID().Value(),
+				Name:          mediumCategory.Name(),
+				BigCategoryID: mediumCategory.BigCategoryID().Value(),
+			})
+		}
+	}
+
+	for _, customCategory := range customCategories {
+		if outBigCategory, ok := outBigCategories[customCategory.BigCategoryID()]; ok {
+			outBigCategory.CustomCategories = append(outBigCategory.CustomCategories, toCustomCategoryOutput(customCategory))
+		}
+	}
+
+	return out, nil
+}
+
+func (u *categoryUsecase) CreateCustomCategory(ctx context.Context, user *input.User, in *input.CustomCategory) (*output.CustomCategory, error) {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return nil, apperror.NewFieldError("user_id", err)
+	}
+
+	name, err := vo.NewCategoryName(in.Name)
+	if err != nil {
+		return nil, apperror.NewFieldError("name", err)
+	}
+
+	bigCategoryID, err := vo.NewBigCategoryID(in.BigCategoryID)
+	if err != nil {
+		return nil, apperror.NewFieldError("big_category_id", err)
+	}
+
+	bigCategories, err := u.categoryRepository.GetBigCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !containsBigCategory(bigCategories, bigCategoryID) {
+		return nil, apperror.NewValidationError("big_category_id", "invalid big category id: %d", bigCategoryID)
+	}
+
+	customCategory := categorydomain.NewCustomCategory(0, name, bigCategoryID, userID)
+	if err := u.checkDuplicateCategoryName(ctx, customCategory); err != nil {
+		return nil, err
+	}
+
+	id, err := u.categoryRepository.CreateCustomCategory(ctx, customCategory)
+	if err != nil {
+		return nil, err
+	}
+
+	return toCustomCategoryOutput(categorydomain.NewCustomCategory(id, name, bigCategoryID, userID)), nil
+}
+
+func (u *categoryUsecase) EditCustomCategory(ctx context.Context, user *input.User, in *input.CustomCategory) (*output.CustomCategory, error) {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return nil, apperror.NewFieldError("user_id", err)
+	}
+
+	name, err := vo.NewCategoryName(in.Name)
+	if err != nil {
+		return nil, apperror.NewFieldError("name", err)
+	}
+
+	customCategoryID, err := vo.NewCustomCategoryID(in.ID)
+	if err != nil {
+		return nil, apperror.NewFieldError("id", err)
+	}
+
+	customCategory, err := u.categoryRepository.GetCustomCategory(ctx, userID, customCategoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	customCategory.Rename(name)
+	if err := u.checkDuplicateCategoryName(ctx, customCategory); err != nil {
+		return nil, err
+	}
+
+	if err := u.categoryRepository.EditCustomCategory(ctx, customCategory); err != nil {
+		return nil, err
+	}
+
+	return toCustomCategoryOutput(customCategory), nil
+}
+
+func (u *categoryUsecase) DeleteCustomCategory(ctx context.Context, user *input.User, in *input.CustomCategory) error {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return apperror.NewFieldError("user_id", err)
+	}
+
+	customCategoryID, err := vo.NewCustomCategoryID(in.ID)
+	if err != nil {
+		return apperror.NewFieldError("id", err)
+	}
+
+	if err := u.categoryRepository.DeleteCustomCategory(ctx, userID, customCategoryID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// checkDuplicateCategoryName checks that the name of the custom category is used neither by the medium categories
+// nor by the other custom categories of the user in the same big category.
+func (u *categoryUsecase) checkDuplicateCategoryName(ctx context.Context, customCategory *categorydomain.CustomCategory) error {
+	mediumCategories, err := u.categoryRepository.GetMediumCategories(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, mediumCategory := range mediumCategories {
+		if mediumCategory.BigCategoryID() == customCategory.BigCategoryID() && mediumCategory.Name() == customCategory.Name().Value() {
+			return apperror.NewConflictError(apperror.ReasonCategoryNameAlreadyExists, "category name already exists: %s", customCategory.Name())
+		}
+	}
+
+	customCategories, err := u.categoryRepository.GetCustomCategories(ctx, customCategory.UserID())
+	if err != nil {
+		return err
+	}
+
+	for _, c := range customCategories {
+		if c.ID() != customCategory.ID() && c.BigCategoryID() == customCategory.BigCategoryID() && c.Name() == customCategory.Name() {
+			return apperror.NewConflictError(apperror.ReasonCategoryNameAlreadyExists, "category name already exists: %s", customCategory.Name())
+		}
+	}
+
+	return nil
+}
+
+// getBudgetableBigCategoryIDs returns the ids of the big categories the standard budgets are created for.
+func getBudgetableBigCategoryIDs(ctx context.Context, categoryRepository categorydomain.Repository) ([]vo.BigCategoryID, error) {
+	bigCategories, err := categoryRepository.GetBigCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var bigCategoryIDs []vo.BigCategoryID
+	for _, bigCategory := range bigCategories {
+		if bigCategory.IsBudgetable() {
+			bigCategoryIDs = append(bigCategoryIDs, bigCategory.ID())
+		}
+	}
+
+	if len(bigCategoryIDs) == 0 {
+		return nil, apperror.NewInternalError(xerrors.New("no budgetable big categories found"))
+	}
+
+	return bigCategoryIDs, nil
+}
+
+func containsBigCategory(bigCategories []*categorydomain.BigCategory, bigCategoryID vo.BigCategoryID) bool {
+	for _, bigCategory := range bigCategories {
+		if bigCategory.ID() == bigCategoryID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func toCustomCategoryOutput(customCategory *categorydomain.CustomCategory) *output.CustomCategory {
+	return &output.CustomCategory{
+		ID:            customCategory.ID().Value(),
+		Name:          customCategory.Name().Value(),
+		BigCategoryID: customCategory.BigCategoryID().Value(),
+	}
+}
@@ -0,0 +1,145 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/paypay3/tukecholl-api/account/apperror"
+	"github.com/paypay3/tukecholl-api/account/domain/categorydomain"
+	"github.com/paypay3/tukecholl-api/account/domain/vo"
+)
+
+// categoryCatalog holds the categories available to a user, to validate the categories of transactions
+// and to resolve their names.
+type categoryCatalog struct {
+	bigCategories    map[vo.BigCategoryID]*categorydomain.BigCategory
+	mediumCategories map[vo.MediumCategoryID]*categorydomain.MediumCategory
+	customCategories map[vo.CustomCategoryID]*categorydomain.CustomCategory
+}
+
+func newCategoryCatalog(ctx context.Context, categoryRepository categorydomain.Repository, userID vo.UserID) (*categoryCatalog, error) {
+	c, err := newMasterCategoryCatalog(ctx, categoryRepository)
+	if err != nil {
+		return nil, err
+	}
+
+	customCategories, err := categoryRepository.GetCustomCategories(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, customCategory := range customCategories {
+		c.customCategories[customCategory.ID()] = customCategory
+	}
+
+	return c, nil
+}
+
+// newMasterCategoryCatalog holds only the big and medium categories, so any custom category is rejected.
+// it is used for groups, which have no custom categories yet.
+func newMasterCategoryCatalog(ctx context.Context, categoryRepository categorydomain.Repository) (*categoryCatalog, error) {
+	bigCategories, err := categoryRepository.GetBigCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mediumCategories, err := categoryRepository.GetMediumCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &categoryCatalog{
+		bigCategories:    make(map[vo.BigCategoryID]*categorydomain.BigCategory, len(bigCategories)),
+		mediumCategories: make(map[vo.MediumCategoryID]*categorydomain.MediumCategory, len(mediumCategories)),
+		customCategories: make(map[vo.CustomCategoryID]*categorydomain.CustomCategory),
+	}
+
+	for _, bigCategory := range bigCategories {
+		c.bigCategories[bigCategory.ID()] = bigCategory
+	}
+
+	for _, mediumCategory := range mediumCategories {
+		c.mediumCategories[mediumCategory.ID()] = mediumCategory
+	}
+
+	return c, nil
+}
+
+// newCategoryIDs validates the category ids of the input. the medium and custom category ids are 0 if not set.
+func newCategoryIDs(bigCategoryID, mediumCategoryID, customCategoryID int) (vo.BigCategoryID, vo.MediumCategoryID, vo.CustomCategoryID, error) {
+	big, err := vo.NewBigCategoryID(bigCategoryID)
+	if err != nil {
+		return 0, 0, 0, apperror.NewFieldError("big_category_id", err)
+	}
+
+	medium, err := vo.NewMediumCategoryID(mediumCategoryID)
+	if err != nil {
+		return 0, 0, 0, apperror.NewFieldError("medium_category_id", err)
+	}
+
+	custom, err := vo.NewCustomCategoryID(customCategoryID)
+	if err != nil {
+		return 0, 0, 0, apperror.NewFieldError("custom_category_id", err)
+	}
+
+	return big, medium, custom, nil
+}
+
+// validate checks that the big category matches the transaction type, and that the medium or custom category,
+// of which at most one can be set, belongs to the big category.
+func (c *categoryCatalog) validate(
+	transactionType vo.TransactionType,
+	bigCategoryID vo.BigCategoryID,
+	mediumCategoryID vo.MediumCategoryID,
+	customCategoryID vo.CustomCategoryID,
+) error {
+	bigCategory, ok := c.bigCategories[bigCategoryID]
+	if !ok {
+		return apperror.NewValidationError("big_category_id", "invalid big category id: %d", bigCategoryID)
+	}
+
+	if bigCategory.TransactionType() != transactionType {
+		return apperror.NewValidationError("big_category_id", "big category does not match transaction type: %d", bigCategoryID)
+	}
+
+	if mediumCategoryID != 0 && customCategoryID != 0 {
+		return apperror.NewValidationError("custom_category_id", "medium category id and custom category id cannot be set at the same time")
+	}
+
+	if mediumCategoryID != 0 {
+		if mediumCategory, ok := c.mediumCategories[mediumCategoryID]; !ok || mediumCategory.BigCategoryID() != bigCategoryID {
+			return apperror.NewValidationError("medium_category_id", "invalid medium category id: %d", mediumCategoryID)
+		}
+	}
+
+	if customCategoryID != 0 {
+		if customCategory, ok := c.customCategories[customCategoryID]; !ok || customCategory.BigCategoryID() != bigCategoryID {
+			return apperror.NewValidationError("custom_category_id", "invalid custom category id: %d", customCategoryID)
+		}
+	}
+
+	return nil
+}
+
+func (c *categoryCatalog) bigCategoryName(bigCategoryID vo.BigCategoryID) string {
+	if bigCategory, ok := c.bigCategories[bigCategoryID]; ok {
+		return bigCategory.Name()
+	}
+
+	return ""
+}
+
+func (c *categoryCatalog) mediumCategoryName(mediumCategoryID vo.MediumCategoryID) string {
+	if mediumCategory, ok := c.mediumCategories[mediumCategoryID]; ok {
+		return mediumCategory.Name()
+	}
+
+	return ""
+}
+
+func (c *categoryCatalog) customCategoryName(customCategoryID vo.CustomCategoryID) string {
+	if customCategory, ok := c.customCategories[customCategoryID]; ok {
+		return customCategory.Name().Value()
+	}
+
+	return ""
+}
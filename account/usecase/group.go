@@ -0,0 +1,343 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/paypay3/tukecholl-api/account/apperror"
+	"github.com/paypay3/tukecholl-api/account/domain/budgetdomain"
+	"github.com/paypay3/tukecholl-api/account/domain/categorydomain"
+	"github.com/paypay3/tukecholl-api/account/domain/groupdomain"
+	"github.com/paypay3/tukecholl-api/account/domain/transactiondomain"
+	"github.com/paypay3/tukecholl-api/account/domain/userdomain"
+	"github.com/paypay3/tukecholl-api/account/domain/vo"
+	"github.com/paypay3/tukecholl-api/account/usecase/input"
+	"github.com/paypay3/tukecholl-api/account/usecase/output"
+)
+
+type GroupUsecase interface {
+	CreateGroup(ctx context.Context, user *input.User, in *input.Group) (*output.Group, error)
+	ListGroups(ctx context.Context, user *input.User) (*output.Groups, error)
+	InviteUser(ctx context.Context, user *input.User, in *input.Invitation) error
+	AcceptInvitation(ctx context.Context, user *input.User, in *input.Group) (*output.Group, error)
+	DeclineInvitation(ctx context.Context, user *input.User, in *input.Group) error
+	LeaveGroup(ctx context.Context, user *input.User, in *input.Group) error
+	ListMembers(ctx context.Context, user *input.User, in *input.Group) (*output.Members, error)
+	GetGroupAccountsSettlement(ctx context.Context, user *input.User, group *input.Group, in *input.GroupAccountsSettlement) (*output.GroupAccountsSettlement, error)
+}
+
+type groupUsecase struct {
+	transactionManager    TransactionManager
+	groupRepository       groupdomain.Repository
+	userRepository        userdomain.Repository
+	budgetRepository      budgetdomain.Repository
+	categoryRepository    categorydomain.Repository
+	transactionRepository transactiondomain.Repository
+}
+
+func NewGroupUsecase(
+	transactionManager TransactionManager,
+	groupRepository groupdomain.Repository,
+	userRepository userdomain.Repository,
+	budgetRepository budgetdomain.Repository,
+	categoryRepository categorydomain.Repository,
+	transactionRepository transactiondomain.Repository,
+) *groupUsecase {
+	return &groupUsecase{
+		transactionManager:    transactionManager,
+		groupRepository:       groupRepository,
+		userRepository:        userRepository,
+		budgetRepository:      budgetRepository,
+		categoryRepository:    categoryRepository,
+		transactionRepository: transactionRepository,
+	}
+}
+
+// CreateGroup creates a group with its standard budgets, whose first member is the user creating it.
+func (u *groupUsecase) CreateGroup(ctx context.Context, user *input.User, in *input.Group) (*output.Group, error) {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return nil, apperror.NewFieldError("user_id", err)
+	}
+
+	name, err := vo.NewGroupName(in.Name)
+	if err != nil {
+		return nil, apperror.NewFieldError("group_name", err)
+	}
+
+	bigCategoryIDs, err := getBudgetableBigCategoryIDs(ctx, u.categoryRepository)
+	if err != nil {
+		return nil, err
+	}
+
+	var groupID int
+	// the group must never exist without members or the standard budgets.
+	if err := u.transactionManager.Transaction(ctx, func(ctx context.Context) error {
+		id, err := u.groupRepository.CreateGroup(ctx, name)
+		if err != nil {
+			return err
+		}
+
+		groupID = id
+
+		if err := u.groupRepository.CreateApprovedUser(ctx, groupID, userID); err != nil {
+			return err
+		}
+
+		return u.budgetRepository.CreateGroupStandardBudgets(ctx, groupID, bigCategoryIDs)
+	}); err != nil {
+		return nil, err
+	}
+
+	return toGroupOutput(groupdomain.NewGroup(groupID, name)), nil
+}
+
+// ListGroups lists the groups the user belongs to and the groups the user is invited to.
+func (u *groupUsecase) ListGroups(ctx context.Context, user *input.User) (*output.Groups, error) {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return nil, apperror.NewFieldError("user_id", err)
+	}
+
+	approvedGroups, err := u.groupRepository.GetApprovedGroups(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	unapprovedGroups, err := u.groupRepository.GetUnapprovedGroups(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &output.Groups{
+		ApprovedGroups:   toGroupsOutput(approvedGroups),
+		UnapprovedGroups: toGroupsOutput(unapprovedGroups),
+	}, nil
+}
+
+// InviteUser invites another user to the group. only the members of the group can invite users.
+func (u *groupUsecase) InviteUser(ctx context.Context, user *input.User, in *input.Invitation) error {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return apperror.NewFieldError("user_id", err)
+	}
+
+	inviteeUserID, err := vo.NewUserID(in.InviteeUserID)
+	if err != nil {
+		return apperror.NewFieldError("invitee_user_id", err)
+	}
+
+	if err := checkGroupMember(ctx, u.groupRepository, in.GroupID, userID); err != nil {
+		return err
+	}
+
+	if exists, err := u.userRepository.ExistsUserID(ctx, inviteeUserID); err != nil {
+		return err
+	} else if !exists {
+		return apperror.NewNotFoundError(apperror.ReasonUserNotFound, "invitee user not found: %s", inviteeUserID)
+	}
+
+	if isMember, err := u.groupRepository.IsApprovedUser(ctx, in.GroupID, inviteeUserID); err != nil {
+		return err
+	} else if isMember {
+		return apperror.NewConflictError(apperror.ReasonGroupUserAlreadyExists, "user already belongs to the group: %d %s", in.GroupID, inviteeUserID)
+	}
+
+	return u.groupRepository.CreateUnapprovedUser(ctx, in.GroupID, inviteeUserID)
+}
+
+// AcceptInvitation makes the invited user a member of the group.
+func (u *groupUsecase) AcceptInvitation(ctx context.Context, user *input.User, in *input.Group) (*output.Group, error) {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return nil, apperror.NewFieldError("user_id", err)
+	}
+
+	if err := u.transactionManager.Transaction(ctx, func(ctx context.Context) error {
+		if err := u.groupRepository.DeleteUnapprovedUser(ctx, in.ID, userID); err != nil {
+			return err
+		}
+
+		return u.groupRepository.CreateApprovedUser(ctx, in.ID, userID)
+	}); err != nil {
+		return nil, err
+	}
+
+	group, err := u.groupRepository.GetGroup(ctx, in.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGroupOutput(group), nil
+}
+
+func (u *groupUsecase) DeclineInvitation(ctx context.Context, user *input.User, in *input.Group) error {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return apperror.NewFieldError("user_id", err)
+	}
+
+	return u.groupRepository.DeleteUnapprovedUser(ctx, in.ID, userID)
+}
+
+// LeaveGroup removes the user from the members of the group.
+// the last member cannot leave, because no one could reach the budgets, the transactions and the todos of the group.
+func (u *groupUsecase) LeaveGroup(ctx context.Context, user *input.User, in *input.Group) error {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return apperror.NewFieldError("user_id", err)
+	}
+
+	// the group is locked so that the last two members cannot leave at the same time.
+	return u.transactionManager.Transaction(ctx, func(ctx context.Context) error {
+		if err := u.groupRepository.LockGroup(ctx, in.ID); err != nil {
+			return err
+		}
+
+		members, err := u.groupRepository.GetApprovedMembers(ctx, in.ID)
+		if err != nil {
+			return err
+		}
+
+		if len(members) == 1 && members[0].UserID() == userID {
+			return apperror.NewFailedPreconditionError(apperror.ReasonLastGroupMember, "the last member cannot leave the group: %d %s", in.ID, userID)
+		}
+
+		return u.groupRepository.DeleteApprovedUser(ctx, in.ID, userID)
+	})
+}
+
+// ListMembers lists the members of the group and the users invited to it. only the members of the group can list them.
+func (u *groupUsecase) ListMembers(ctx context.Context, user *input.User, in *input.Group) (*output.Members, error) {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return nil, apperror.NewFieldError("user_id", err)
+	}
+
+	if err := checkGroupMember(ctx, u.groupRepository, in.ID, userID); err != nil {
+		return nil, err
+	}
+
+	approvedMembers, err := u.groupRepository.GetApprovedMembers(ctx, in.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	unapprovedMembers, err := u.groupRepository.GetUnapprovedMembers(ctx, in.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &output.Members{
+		ApprovedMembers:   toMembersOutput(approvedMembers),
+		UnapprovedMembers: toMembersOutput(unapprovedMembers),
+	}, nil
+}
+
+// GetGroupAccountsSettlement splits the expenses of the group in the month equally among the current members
+// and the users who paid any of them, and computes the transfers to settle them.
+// the users who paid in the month and left the group later take part in the split of the month,
+// so that the total matches the expenses counted by GetBudgetStatus and ListGroupTransactions.
+func (u *groupUsecase) GetGroupAccountsSettlement(ctx context.Context, user *input.User, group *input.Group, in *input.GroupAccountsSettlement) (*output.GroupAccountsSettlement, error) {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return nil, apperror.NewFieldError("user_id", err)
+	}
+
+	yearMonth, err := vo.NewYearMonth(in.YearMonth)
+	if err != nil {
+		return nil, apperror.NewFieldError("years_months", err)
+	}
+
+	if err := checkGroupMember(ctx, u.groupRepository, group.ID, userID); err != nil {
+		return nil, err
+	}
+
+	members, err := u.groupRepository.GetApprovedMembers(ctx, group.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	paymentTotals, err := u.transactionRepository.GetMonthlyPaymentTotals(ctx, group.ID, yearMonth, vo.TransactionTypeExpense)
+	if err != nil {
+		return nil, err
+	}
+
+	paidAmounts := make(map[vo.UserID]int, len(members))
+	for _, member := range members {
+		paidAmounts[member.UserID()] = 0
+	}
+
+	for _, paymentTotal := range paymentTotals {
+		paidAmounts[paymentTotal.PaymentUserID()] = paymentTotal.TotalAmount()
+	}
+
+	settlement := groupdomain.CalculateSettlement(paidAmounts)
+
+	out := &output.GroupAccountsSettlement{
+		YearMonth:      yearMonth.String(),
+		TotalExpense:   settlement.TotalAmount(),
+		MemberAccounts: make([]*output.MemberAccount, len(settlement.MemberAccounts())),
+		Transfers:      make([]*output.SettlementTransfer, len(settlement.Transfers())),
+	}
+
+	for i, memberAccount := range settlement.MemberAccounts() {
+		out.MemberAccounts[i] = &output.MemberAccount{
+			UserID:     memberAccount.UserID().Value(),
+			PaidAmount: memberAccount.PaidAmount(),
+			FairShare:  memberAccount.FairShare(),
+			Balance:    memberAccount.Balance(),
+		}
+	}
+
+	for i, transfer := range settlement.Transfers() {
+		out.Transfers[i] = &output.SettlementTransfer{
+			PayerUserID:     transfer.PayerUserID().Value(),
+			RecipientUserID: transfer.RecipientUserID().Value(),
+			Amount:          transfer.Amount(),
+		}
+	}
+
+	return out, nil
+}
+
+// checkGroupMember returns PermissionDenied if the user does not belong to the group.
+func checkGroupMember(ctx context.Context, groupRepository groupdomain.Repository, groupID int, userID vo.UserID) error {
+	isMember, err := groupRepository.IsApprovedUser(ctx, groupID, userID)
+	if err != nil {
+		return err
+	}
+
+	if !isMember {
+		return apperror.NewPermissionDeniedError(apperror.ReasonNotGroupMember, "user does not belong to the group: %d %s", groupID, userID)
+	}
+
+	return nil
+}
+
+func toGroupOutput(group *groupdomain.Group) *output.Group {
+	return &output.Group{
+		ID:   group.ID(),
+		Name: group.Name().Value(),
+	}
+}
+
+func toGroupsOutput(groups []*groupdomain.Group) []*output.Group {
+	out := make([]*output.Group, len(groups))
+	for i, group := range groups {
+		out[i] = toGroupOutput(group)
+	}
+
+	return out
+}
+
+func toMembersOutput(members []*groupdomain.Member) []*output.Member {
+	out := make([]*output.Member, len(members))
+	for i, member := range members {
+		out[i] = &output.Member{
+			UserID:   member.UserID().Value(),
+			UserName: member.UserName().Value(),
+		}
+	}
+
+	return out
+}
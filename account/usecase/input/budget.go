@@ -0,0 +1,28 @@
+package input
+
+type StandardBudgets struct {
+	StandardBudgets []*StandardBudget
+}
+
+type StandardBudget struct {
+	BigCategoryID int
+	Budget        int
+}
+
+type CustomBudgets struct {
+	YearMonth     string
+	CustomBudgets []*CustomBudget
+}
+
+type CustomBudget struct {
+	BigCategoryID int
+	Budget        int
+}
+
+type YearlyBudget struct {
+	Year int
+}
+
+type BudgetStatus struct {
+	YearMonth string
+}
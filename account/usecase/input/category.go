@@ -0,0 +1,11 @@
+package input
+
+type Categories struct {
+	TransactionType int
+}
+
+type CustomCategory struct {
+	ID            int
+	BigCategoryID int
+	Name          string
+}
@@ -0,0 +1,15 @@
+package input
+
+type Group struct {
+	ID   int
+	Name string
+}
+
+type Invitation struct {
+	GroupID       int
+	InviteeUserID string
+}
+
+type GroupAccountsSettlement struct {
+	YearMonth string
+}
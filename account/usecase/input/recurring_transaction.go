@@ -0,0 +1,17 @@
+package input
+
+type RecurringTransaction struct {
+	ID               int
+	TransactionType  int
+	Shop             string
+	Memo             string
+	Amount           int
+	BigCategoryID    int
+	MediumCategoryID int
+	CustomCategoryID int
+	RecurrenceType   int
+	DayOfMonth       int
+	DayOfWeek        int
+	StartDate        string
+	EndDate          string
+}
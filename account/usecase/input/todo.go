@@ -0,0 +1,38 @@
+package input
+
+type Todo struct {
+	ID                 int
+	ImplementationDate string
+	DueDate            string
+	Content            string
+	AssigneeUserID     string
+}
+
+type ShoppingItem struct {
+	ID                   int
+	ExpectedPurchaseDate string
+	Name                 string
+	Shop                 string
+	Amount               int
+	AssigneeUserID       string
+	BigCategoryID        int
+	MediumCategoryID     int
+}
+
+// Completion changes the completion of a todo or a shopping item.
+type Completion struct {
+	ID        int
+	Completed bool
+}
+
+// Period is either a month or a date.
+type Period struct {
+	YearMonth string
+	Date      string
+}
+
+type Purchase struct {
+	ID              int
+	Amount          int
+	TransactionDate string
+}
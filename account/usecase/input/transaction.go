@@ -0,0 +1,44 @@
+package input
+
+type Transaction struct {
+	ID               int
+	TransactionType  int
+	TransactionDate  string
+	Shop             string
+	Memo             string
+	Amount           int
+	BigCategoryID    int
+	MediumCategoryID int
+	CustomCategoryID int
+}
+
+type Transactions struct {
+	YearMonth string
+}
+
+type SearchTransactions struct {
+	TransactionType  int
+	StartDate        string
+	EndDate          string
+	BigCategoryID    int
+	MediumCategoryID int
+	CustomCategoryID int
+	LowAmount        int
+	HighAmount       int
+	Keyword          string
+	SortField        int
+	SortOrder        int
+	Limit            int
+}
+
+type GroupTransaction struct {
+	ID               int
+	TransactionType  int
+	TransactionDate  string
+	Shop             string
+	Memo             string
+	Amount           int
+	PaymentUserID    string
+	BigCategoryID    int
+	MediumCategoryID int
+}
@@ -1,5 +1,8 @@
 package input
 
 type User struct {
-	ID string
+	ID       string
+	Name     string
+	Email    string
+	Password string
 }
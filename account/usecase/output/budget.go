@@ -0,0 +1,69 @@
+package output
+
+type BudgetType int
+
+const (
+	BudgetTypeStandard BudgetType = iota + 1
+	BudgetTypeCustom
+)
+
+type StandardBudgets struct {
+	StandardBudgets []*StandardBudget
+}
+
+type StandardBudget struct {
+	BigCategoryID   int
+	BigCategoryName string
+	Budget          int
+}
+
+type CustomBudgets struct {
+	YearMonth     string
+	BudgetType    BudgetType
+	CustomBudgets []*CustomBudget
+}
+
+type CustomBudget struct {
+	BigCategoryID   int
+	BigCategoryName string
+	Budget          int
+}
+
+type YearlyBudget struct {
+	Year               int
+	TotalBudget        int
+	BigCategoryBudgets []*BigCategoryBudget
+	MonthlyBudgets     []*MonthlyBudget
+}
+
+type MonthlyBudget struct {
+	YearMonth          string
+	BudgetType         BudgetType
+	TotalBudget        int
+	BigCategoryBudgets []*BigCategoryBudget
+}
+
+type BigCategoryBudget struct {
+	BigCategoryID   int
+	BigCategoryName string
+	Budget          int
+}
+
+// BudgetStatus compares the budgets of a month with the expenses.
+// Remaining is negative if the expense is over the budget.
+type BudgetStatus struct {
+	YearMonth                 string
+	BudgetType                BudgetType
+	TotalBudget               int
+	TotalExpense              int
+	TotalRemaining            int
+	BigCategoryBudgetStatuses []*BigCategoryBudgetStatus
+}
+
+type BigCategoryBudgetStatus struct {
+	BigCategoryID   int
+	BigCategoryName string
+	Budget          int
+	Expense         int
+	Remaining       int
+}
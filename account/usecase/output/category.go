@@ -0,0 +1,32 @@
+package output
+
+type TransactionType int
+
+const (
+	TransactionTypeIncome TransactionType = iota + 1
+	TransactionTypeExpense
+)
+
+type Categories struct {
+	BigCategories []*BigCategory
+}
+
+type BigCategory struct {
+	ID               int
+	Name             string
+	TransactionType  TransactionType
+	MediumCategories []*MediumCategory
+	CustomCategories []*CustomCategory
+}
+
+type MediumCategory struct {
+	ID            int
+	Name          string
+	BigCategoryID int
+}
+
+type CustomCategory struct {
+	ID            int
+	Name          string
+	BigCategoryID int
+}
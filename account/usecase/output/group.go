@@ -0,0 +1,41 @@
+package output
+
+type Group struct {
+	ID   int
+	Name string
+}
+
+type Groups struct {
+	ApprovedGroups   []*Group
+	UnapprovedGroups []*Group
+}
+
+type Member struct {
+	UserID   string
+	UserName string
+}
+
+type Members struct {
+	ApprovedMembers   []*Member
+	UnapprovedMembers []*Member
+}
+
+type GroupAccountsSettlement struct {
+	YearMonth      string
+	TotalExpense   int
+	MemberAccounts []*MemberAccount
+	Transfers      []*SettlementTransfer
+}
+
+type MemberAccount struct {
+	UserID     string
+	PaidAmount int
+	FairShare  int
+	Balance    int
+}
+
+type SettlementTransfer struct {
+	PayerUserID     string
+	RecipientUserID string
+	Amount          int
+}
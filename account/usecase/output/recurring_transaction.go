@@ -0,0 +1,32 @@
+package output
+
+type RecurrenceType int
+
+const (
+	RecurrenceTypeMonthly RecurrenceType = iota + 1
+	RecurrenceTypeWeekly
+)
+
+type RecurringTransactions struct {
+	RecurringTransactions []*RecurringTransaction
+}
+
+type RecurringTransaction struct {
+	ID                 int
+	TransactionType    TransactionType
+	Shop               string
+	Memo               string
+	Amount             int
+	BigCategoryID      int
+	BigCategoryName    string
+	MediumCategoryID   int
+	MediumCategoryName string
+	CustomCategoryID   int
+	CustomCategoryName string
+	RecurrenceType     RecurrenceType
+	DayOfMonth         int
+	DayOfWeek          int
+	StartDate          string
+	EndDate            string
+	LastExecutionDate  string
+}
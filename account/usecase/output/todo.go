@@ -0,0 +1,39 @@
+package output
+
+type Todos struct {
+	Todos []*Todo
+}
+
+type Todo struct {
+	ID                 int
+	PostedDate         string
+	UpdatedDate        string
+	ImplementationDate string
+	DueDate            string
+	Content            string
+	Completed          bool
+	PostedUserID       string
+	AssigneeUserID     string
+}
+
+type ShoppingItems struct {
+	ShoppingItems []*ShoppingItem
+}
+
+type ShoppingItem struct {
+	ID                   int
+	PostedDate           string
+	UpdatedDate          string
+	ExpectedPurchaseDate string
+	Name                 string
+	Shop                 string
+	Amount               int
+	Completed            bool
+	PostedUserID         string
+	AssigneeUserID       string
+	BigCategoryID        int
+	BigCategoryName      string
+	MediumCategoryID     int
+	MediumCategoryName   string
+	TransactionID        int
+}
@@ -0,0 +1,44 @@
+package output
+
+type Transactions struct {
+	Transactions []*Transaction
+}
+
+type Transaction struct {
+	ID                 int
+	TransactionType    TransactionType
+	PostedDate         string
+	UpdatedDate        string
+	TransactionDate    string
+	Shop               string
+	Memo               string
+	Amount             int
+	BigCategoryID      int
+	BigCategoryName    string
+	MediumCategoryID   int
+	MediumCategoryName string
+	CustomCategoryID   int
+	CustomCategoryName string
+}
+
+type GroupTransactions struct {
+	Transactions []*GroupTransaction
+}
+
+type GroupTransaction struct {
+	ID                 int
+	TransactionType    TransactionType
+	PostedDate         string
+	UpdatedDate        string
+	TransactionDate    string
+	Shop               string
+	Memo               string
+	Amount             int
+	PostedUserID       string
+	UpdatedUserID      string
+	PaymentUserID      string
+	BigCategoryID      int
+	BigCategoryName    string
+	MediumCategoryID   int
+	MediumCategoryName string
+}
@@ -0,0 +1,7 @@
+package output
+
+type User struct {
+	ID    string
+	Name  string
+	Email string
+}
@@ -0,0 +1,387 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/paypay3/tukecholl-api/account/apperror"
+	"github.com/paypay3/tukecholl-api/account/domain/categorydomain"
+	"github.com/paypay3/tukecholl-api/account/domain/recurringdomain"
+	"github.com/paypay3/tukecholl-api/account/domain/transactiondomain"
+	"github.com/paypay3/tukecholl-api/account/domain/vo"
+	"github.com/paypay3/tukecholl-api/account/logger"
+	"github.com/paypay3/tukecholl-api/account/usecase/input"
+	"github.com/paypay3/tukecholl-api/account/usecase/output"
+)
+
+// maxDueDatesPerExecution bounds the transactions recorded for a recurring transaction in a run,
+// which is about two years of a weekly one. the rest are recorded by the following runs.
+const maxDueDatesPerExecution = 100
+
+type RecurringTransactionUsecase interface {
+	CreateRecurringTransaction(ctx context.Context, user *input.User, in *input.RecurringTransaction) (*output.RecurringTransaction, error)
+	ListRecurringTransactions(ctx context.Context, user *input.User) (*output.RecurringTransactions, error)
+	EditRecurringTransaction(ctx context.Context, user *input.User, in *input.RecurringTransaction) (*output.RecurringTransaction, error)
+	DeleteRecurringTransaction(ctx context.Context, user *input.User, in *input.RecurringTransaction) error
+	ExecuteRecurringTransactions(ctx context.Context, user *input.User) (*output.Transactions, error)
+	ExecuteAllRecurringTransactions(ctx context.Context, now time.Time) (int, error)
+}
+
+type recurringTransactionUsecase struct {
+	transactionManager             TransactionManager
+	recurringTransactionRepository recurringdomain.Repository
+	transactionRepository          transactiondomain.Repository
+	categoryRepository             categorydomain.Repository
+}
+
+func NewRecurringTransactionUsecase(
+	transactionManager TransactionManager,
+	recurringTransactionRepository recurringdomain.Repository,
+	transactionRepository transactiondomain.Repository,
+	categoryRepository categorydomain.Repository,
+) *recurringTransactionUsecase {
+	return &recurringTransactionUsecase{
+		transactionManager:             transactionManager,
+		recurringTransactionRepository: recurringTransactionRepository,
+		transactionRepository:          transactionRepository,
+		categoryRepository:             categoryRepository,
+	}
+}
+
+func (u *recurringTransactionUsecase) CreateRecurringTransaction(ctx context.Context, user *input.User, in *input.RecurringTransaction) (*output.RecurringTransaction, error) {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return nil, apperror.NewFieldError("user_id", err)
+	}
+
+	categoryCatalog, err := newCategoryCatalog(ctx, u.categoryRepository, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	recurringTransaction, err := newRecurringTransaction(0, userID, in, categoryCatalog)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := u.recurringTransactionRepository.CreateRecurringTransaction(ctx, recurringTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	createdRecurringTransaction, err := u.recurringTransactionRepository.GetRecurringTransaction(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toRecurringTransactionOutput(createdRecurringTransaction, categoryCatalog), nil
+}
+
+func (u *recurringTransactionUsecase) ListRecurringTransactions(ctx context.Context, user *input.User) (*output.RecurringTransactions, error) {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return nil, apperror.NewFieldError("user_id", err)
+	}
+
+	recurringTransactions, err := u.recurringTransactionRepository.GetRecurringTransactions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryCatalog, err := newCategoryCatalog(ctx, u.categoryRepository, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &output.RecurringTransactions{
+		RecurringTransactions: make([]*output.RecurringTransaction, len(recurringTransactions)),
+	}
+
+	for i, recurringTransaction := range recurringTransactions {
+		out.RecurringTransactions[i] = toRecurringTransactionOutput(recurringTransaction, categoryCatalog)
+	}
+
+	return out, nil
+}
+
+// EditRecurringTransaction does not change the transactions which have already been recorded.
+func (u *recurringTransactionUsecase) EditRecurringTransaction(ctx context.Context, user *input.User, in *input.RecurringTransaction) (*output.RecurringTransaction, error) {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return nil, apperror.NewFieldError("user_id", err)
+	}
+
+	if _, err := u.recurringTransactionRepository.GetRecurringTransaction(ctx, userID, in.ID); err != nil {
+		return nil, err
+	}
+
+	categoryCatalog, err := newCategoryCatalog(ctx, u.categoryRepository, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	recurringTransaction, err := newRecurringTransaction(in.ID, userID, in, categoryCatalog)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.recurringTransactionRepository.EditRecurringTransaction(ctx, recurringTransaction); err != nil {
+		return nil, err
+	}
+
+	updatedRecurringTransaction, err := u.recurringTransactionRepository.GetRecurringTransaction(ctx, userID, in.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toRecurringTransactionOutput(updatedRecurringTransaction, categoryCatalog), nil
+}
+
+// DeleteRecurringTransaction does not delete the transactions which have already been recorded.
+func (u *recurringTransactionUsecase) DeleteRecurringTransaction(ctx context.Context, user *input.User, in *input.RecurringTransaction) error {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return apperror.NewFieldError("user_id", err)
+	}
+
+	if err := u.recurringTransactionRepository.DeleteRecurringTransaction(ctx, userID, in.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ExecuteRecurringTransactions records the transactions of the user which are due by today, and returns the recorded ones.
+// at most maxDueDatesPerExecution transactions are recorded for each recurring transaction.
+func (u *recurringTransactionUsecase) ExecuteRecurringTransactions(ctx context.Context, user *input.User) (*output.Transactions, error) {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return nil, apperror.NewFieldError("user_id", err)
+	}
+
+	recurringTransactions, err := u.recurringTransactionRepository.GetRecurringTransactions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionIDs, err := u.executeRecurringTransactions(ctx, recurringTransactions, toDate(time.Now()))
+	if err != nil {
+		return nil, err
+	}
+
+	categoryCatalog, err := newCategoryCatalog(ctx, u.categoryRepository, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &output.Transactions{
+		Transactions: make([]*output.Transaction, len(transactionIDs)),
+	}
+
+	for i, transactionID := range transactionIDs {
+		transaction, err := u.transactionRepository.GetTransaction(ctx, userID, transactionID)
+		if err != nil {
+			return nil, err
+		}
+
+		out.Transactions[i] = toTransactionOutput(transaction, categoryCatalog)
+	}
+
+	return out, nil
+}
+
+// ExecuteAllRecurringTransactions records the transactions of all users which are due by the date of now,
+// and returns the number of the recorded transactions. it is run by the scheduler.
+// a recurring transaction which fails does not stop the others, and the number is returned with the error of the failures.
+func (u *recurringTransactionUsecase) ExecuteAllRecurringTransactions(ctx context.Context, now time.Time) (int, error) {
+	today := toDate(now)
+
+	recurringTransactions, err := u.recurringTransactionRepository.GetActiveRecurringTransactions(ctx, today)
+	if err != nil {
+		return 0, err
+	}
+
+	transactionIDs, err := u.executeRecurringTransactions(ctx, recurringTransactions, today)
+	if err != nil {
+		return len(transactionIDs), err
+	}
+
+	return len(transactionIDs), nil
+}
+
+// executeRecurringTransactions records a transaction for each due date of the recurring transactions.
+// each date is recorded with its execution in a transaction, and the dates already executed by another run are skipped,
+// so that it is idempotent even if the scheduler and the manual execution run at the same time.
+// a recurring transaction which fails is logged and skipped, and the failures are returned together after all of them are run,
+// with the ids of the transactions recorded anyway.
+func (u *recurringTransactionUsecase) executeRecurringTransactions(ctx context.Context, recurringTransactions []*recurringdomain.RecurringTransaction, today time.Time) ([]int, error) {
+	transactionIDs := make([]int, 0)
+	var failures recurringTransactionErrors
+	for _, recurringTransaction := range recurringTransactions {
+		ids, err := u.executeRecurringTransaction(ctx, recurringTransaction, today)
+		transactionIDs = append(transactionIDs, ids...)
+		if err == nil {
+			continue
+		}
+
+		// the rest would fail in the same way once ctx is done.
+		if ctx.Err() != nil {
+			return transactionIDs, err
+		}
+
+		logger.FromContext(ctx).Error("failed to execute a recurring transaction",
+			"recurring_transaction_id", recurringTransaction.ID(), "error", fmt.Sprintf("%+v", err))
+
+		failures = append(failures, &recurringTransactionError{id: recurringTransaction.ID(), err: err})
+	}
+
+	if len(failures) > 0 {
+		return transactionIDs, apperror.NewInternalError(failures)
+	}
+
+	return transactionIDs, nil
+}
+
+func (u *recurringTransactionUsecase) executeRecurringTransaction(ctx context.Context, recurringTransaction *recurringdomain.RecurringTransaction, today time.Time) ([]int, error) {
+	transactionIDs := make([]int, 0)
+	for _, dueDate := range recurringTransaction.DueDates(today, maxDueDatesPerExecution) {
+		var transactionID int
+		if err := u.transactionManager.Transaction(ctx, func(ctx context.Context) error {
+			id, err := u.transactionRepository.CreateTransaction(ctx, recurringTransaction.ToTransaction(dueDate))
+			if err != nil {
+				return err
+			}
+
+			transactionID = id
+
+			return u.recurringTransactionRepository.CreateExecution(ctx, recurringTransaction.ID(), dueDate, transactionID)
+		}); err != nil {
+			if apperror.Is(err, apperror.KindConflict) {
+				continue
+			}
+
+			return transactionIDs, err
+		}
+
+		transactionIDs = append(transactionIDs, transactionID)
+	}
+
+	return transactionIDs, nil
+}
+
+type recurringTransactionError struct {
+	id  int
+	err error
+}
+
+// recurringTransactionErrors is the failures of the recurring transactions in a run.
+type recurringTransactionErrors []*recurringTransactionError
+
+func (e recurringTransactionErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, failure := range e {
+		messages[i] = fmt.Sprintf("recurring transaction %d: %v", failure.id, failure.err)
+	}
+
+	return fmt.Sprintf("failed to execute %d recurring transactions: %s", len(e), strings.Join(messages, "; "))
+}
+
+// newRecurringTransaction validates the input in the same way as transactions, taking the start date as the transaction date.
+func newRecurringTransaction(id int, userID vo.UserID, in *input.RecurringTransaction, categoryCatalog *categoryCatalog) (*recurringdomain.RecurringTransaction, error) {
+	transaction, err := newTransaction(0, userID, &input.Transaction{
+		TransactionType:  in.TransactionType,
+		TransactionDate:  in.StartDate,
+		Shop:             in.Shop,
+		Memo:             in.Memo,
+		Amount:           in.Amount,
+		BigCategoryID:    in.BigCategoryID,
+		MediumCategoryID: in.MediumCategoryID,
+		CustomCategoryID: in.CustomCategoryID,
+	}, categoryCatalog)
+	if err != nil {
+		return nil, err
+	}
+
+	var recurrenceRule *recurringdomain.RecurrenceRule
+	switch recurringdomain.RecurrenceType(in.RecurrenceType) {
+	case recurringdomain.RecurrenceTypeMonthly:
+		if recurrenceRule, err = recurringdomain.NewMonthlyRecurrenceRule(in.DayOfMonth); err != nil {
+			return nil, apperror.NewFieldError("day_of_month", err)
+		}
+	case recurringdomain.RecurrenceTypeWeekly:
+		if recurrenceRule, err = recurringdomain.NewWeeklyRecurrenceRule(in.DayOfWeek); err != nil {
+			return nil, apperror.NewFieldError("day_of_week", err)
+		}
+	default:
+		return nil, apperror.NewValidationError("recurrence_type", "invalid recurrence type: %d", in.RecurrenceType)
+	}
+
+	var endDate time.Time
+	if in.EndDate != "" {
+		if endDate, err = time.Parse(dateLayout, in.EndDate); err != nil {
+			return nil, apperror.NewValidationError("end_date", "invalid end date: %s", in.EndDate)
+		}
+
+		if endDate.Before(transaction.TransactionDate()) {
+			return nil, apperror.NewValidationError("end_date", "end date must be on or after start date: %s %s", in.StartDate, in.EndDate)
+		}
+	}
+
+	return recurringdomain.NewRecurringTransaction(
+		id,
+		transaction.TransactionType(),
+		transaction.Shop(),
+		transaction.Memo(),
+		transaction.Amount(),
+		userID,
+		transaction.BigCategoryID(),
+		transaction.MediumCategoryID(),
+		transaction.CustomCategoryID(),
+		recurrenceRule,
+		transaction.TransactionDate(),
+		endDate,
+		time.Time{},
+	), nil
+}
+
+// toDate truncates t to the date in the location of t, and returns it in UTC to compare with the dates parsed from the database.
+func toDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func toRecurringTransactionOutput(recurringTransaction *recurringdomain.RecurringTransaction, categoryCatalog *categoryCatalog) *output.RecurringTransaction {
+	out := &output.RecurringTransaction{
+		ID:                 recurringTransaction.ID(),
+		TransactionType:    output.TransactionType(recurringTransaction.TransactionType().Value()),
+		Shop:               recurringTransaction.Shop().Value(),
+		Memo:               recurringTransaction.Memo().Value(),
+		Amount:             recurringTransaction.Amount().Value(),
+		BigCategoryID:      recurringTransaction.BigCategoryID().Value(),
+		BigCategoryName:    categoryCatalog.bigCategoryName(recurringTransaction.BigCategoryID()),
+		MediumCategoryID:   recurringTransaction.MediumCategoryID().Value(),
+		MediumCategoryName: categoryCatalog.mediumCategoryName(recurringTransaction.MediumCategoryID()),
+		CustomCategoryID:   recurringTransaction.CustomCategoryID().Value(),
+		CustomCategoryName: categoryCatalog.customCategoryName(recurringTransaction.CustomCategoryID()),
+		RecurrenceType:     output.RecurrenceType(recurringTransaction.RecurrenceRule().RecurrenceType()),
+		StartDate:          recurringTransaction.StartDate().Format(dateLayout),
+	}
+
+	switch recurringTransaction.RecurrenceRule().RecurrenceType() {
+	case recurringdomain.RecurrenceTypeMonthly:
+		out.DayOfMonth = recurringTransaction.RecurrenceRule().DayOfMonth()
+	case recurringdomain.RecurrenceTypeWeekly:
+		out.DayOfWeek = int(recurringTransaction.RecurrenceRule().DayOfWeek())
+	}
+
+	if !recurringTransaction.EndDate().IsZero() {
+		out.EndDate = recurringTransaction.EndDate().Format(dateLayout)
+	}
+
+	if !recurringTransaction.LastExecutionDate().IsZero() {
+		out.LastExecutionDate = recurringTransaction.LastExecutionDate().Format(dateLayout)
+	}
+
+	return out
+}
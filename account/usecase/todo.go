@@ -0,0 +1,606 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/paypay3/tukecholl-api/account/apperror"
+	"github.com/paypay3/tukecholl-api/account/domain/categorydomain"
+	"github.com/paypay3/tukecholl-api/account/domain/groupdomain"
+	"github.com/paypay3/tukecholl-api/account/domain/tododomain"
+	"github.com/paypay3/tukecholl-api/account/domain/transactiondomain"
+	"github.com/paypay3/tukecholl-api/account/domain/vo"
+	"github.com/paypay3/tukecholl-api/account/usecase/input"
+	"github.com/paypay3/tukecholl-api/account/usecase/output"
+)
+
+type TodoUsecase interface {
+	CreateTodo(ctx context.Context, user *input.User, group *input.Group, in *input.Todo) (*output.Todo, error)
+	EditTodo(ctx context.Context, user *input.User, group *input.Group, in *input.Todo) (*output.Todo, error)
+	DeleteTodo(ctx context.Context, user *input.User, group *input.Group, in *input.Todo) error
+	ChangeTodoCompletion(ctx context.Context, user *input.User, group *input.Group, in *input.Completion) (*output.Todo, error)
+	ListTodos(ctx context.Context, user *input.User, group *input.Group, in *input.Period) (*output.Todos, error)
+	CreateShoppingItem(ctx context.Context, user *input.User, group *input.Group, in *input.ShoppingItem) (*output.ShoppingItem, error)
+	EditShoppingItem(ctx context.Context, user *input.User, group *input.Group, in *input.ShoppingItem) (*output.ShoppingItem, error)
+	DeleteShoppingItem(ctx context.Context, user *input.User, group *input.Group, in *input.ShoppingItem) error
+	ChangeShoppingItemCompletion(ctx context.Context, user *input.User, group *input.Group, in *input.Completion) (*output.ShoppingItem, error)
+	ListShoppingItems(ctx context.Context, user *input.User, group *input.Group, in *input.Period) (*output.ShoppingItems, error)
+	PurchaseShoppingItem(ctx context.Context, user *input.User, group *input.Group, in *input.Purchase) (*output.ShoppingItem, error)
+}
+
+type todoUsecase struct {
+	transactionManager    TransactionManager
+	todoRepository        tododomain.Repository
+	groupRepository       groupdomain.Repository
+	transactionRepository transactiondomain.Repository
+	categoryRepository    categorydomain.Repository
+}
+
+func NewTodoUsecase(
+	transactionManager TransactionManager,
+	todoRepository tododomain.Repository,
+	groupRepository groupdomain.Repository,
+	transactionRepository transactiondomain.Repository,
+	categoryRepository categorydomain.Repository,
+) *todoUsecase {
+	return &todoUsecase{
+		transactionManager:    transactionManager,
+		todoRepository:        todoRepository,
+		groupRepository:       groupRepository,
+		transactionRepository: transactionRepository,
+		categoryRepository:    categoryRepository,
+	}
+}
+
+func (u *todoUsecase) CreateTodo(ctx context.Context, user *input.User, group *input.Group, in *input.Todo) (*output.Todo, error) {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return nil, apperror.NewFieldError("user_id", err)
+	}
+
+	if err := checkGroupMember(ctx, u.groupRepository, group.ID, userID); err != nil {
+		return nil, err
+	}
+
+	todo, err := u.newTodo(ctx, 0, group.ID, userID, false, in)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := u.todoRepository.CreateTodo(ctx, todo)
+	if err != nil {
+		return nil, err
+	}
+
+	createdTodo, err := u.todoRepository.GetTodo(ctx, group.ID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toTodoOutput(createdTodo), nil
+}
+
+func (u *todoUsecase) EditTodo(ctx context.Context, user *input.User, group *input.Group, in *input.Todo) (*output.Todo, error) {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return nil, apperror.NewFieldError("user_id", err)
+	}
+
+	if err := checkGroupMember(ctx, u.groupRepository, group.ID, userID); err != nil {
+		return nil, err
+	}
+
+	currentTodo, err := u.todoRepository.GetTodo(ctx, group.ID, in.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	todo, err := u.newTodo(ctx, in.ID, group.ID, currentTodo.PostedUserID(), currentTodo.Completed(), in)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.todoRepository.EditTodo(ctx, todo); err != nil {
+		return nil, err
+	}
+
+	updatedTodo, err := u.todoRepository.GetTodo(ctx, group.ID, in.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toTodoOutput(updatedTodo), nil
+}
+
+func (u *todoUsecase) DeleteTodo(ctx context.Context, user *input.User, group *input.Group, in *input.Todo) error {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return apperror.NewFieldError("user_id", err)
+	}
+
+	if err := checkGroupMember(ctx, u.groupRepository, group.ID, userID); err != nil {
+		return err
+	}
+
+	if err := u.todoRepository.DeleteTodo(ctx, group.ID, in.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (u *todoUsecase) ChangeTodoCompletion(ctx context.Context, user *input.User, group *input.Group, in *input.Completion) (*output.Todo, error) {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return nil, apperror.NewFieldError("user_id", err)
+	}
+
+	if err := checkGroupMember(ctx, u.groupRepository, group.ID, userID); err != nil {
+		return nil, err
+	}
+
+	todo, err := u.todoRepository.GetTodo(ctx, group.ID, in.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	todo.SetCompleted(in.Completed)
+	if err := u.todoRepository.EditTodo(ctx, todo); err != nil {
+		return nil, err
+	}
+
+	updatedTodo, err := u.todoRepository.GetTodo(ctx, group.ID, in.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toTodoOutput(updatedTodo), nil
+}
+
+// ListTodos lists the todos due in the month or on the date.
+func (u *todoUsecase) ListTodos(ctx context.Context, user *input.User, group *input.Group, in *input.Period) (*output.Todos, error) {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return nil, apperror.NewFieldError("user_id", err)
+	}
+
+	from, to, err := toPeriod(in)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkGroupMember(ctx, u.groupRepository, group.ID, userID); err != nil {
+		return nil, err
+	}
+
+	todos, err := u.todoRepository.GetTodos(ctx, group.ID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &output.Todos{
+		Todos: make([]*output.Todo, len(todos)),
+	}
+
+	for i, todo := range todos {
+		out.Todos[i] = toTodoOutput(todo)
+	}
+
+	return out, nil
+}
+
+func (u *todoUsecase) CreateShoppingItem(ctx context.Context, user *input.User, group *input.Group, in *input.ShoppingItem) (*output.ShoppingItem, error) {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return nil, apperror.NewFieldError("user_id", err)
+	}
+
+	if err := checkGroupMember(ctx, u.groupRepository, group.ID, userID); err != nil {
+		return nil, err
+	}
+
+	categoryCatalog, err := newMasterCategoryCatalog(ctx, u.categoryRepository)
+	if err != nil {
+		return nil, err
+	}
+
+	shoppingItem, err := u.newShoppingItem(ctx, 0, group.ID, userID, false, 0, in, categoryCatalog)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := u.todoRepository.CreateShoppingItem(ctx, shoppingItem)
+	if err != nil {
+		return nil, err
+	}
+
+	createdShoppingItem, err := u.todoRepository.GetShoppingItem(ctx, group.ID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toShoppingItemOutput(createdShoppingItem, categoryCatalog), nil
+}
+
+// EditShoppingItem does not change the transaction which the item has already been converted into.
+func (u *todoUsecase) EditShoppingItem(ctx context.Context, user *input.User, group *input.Group, in *input.ShoppingItem) (*output.ShoppingItem, error) {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return nil, apperror.NewFieldError("user_id", err)
+	}
+
+	if err := checkGroupMember(ctx, u.groupRepository, group.ID, userID); err != nil {
+		return nil, err
+	}
+
+	currentShoppingItem, err := u.todoRepository.GetShoppingItem(ctx, group.ID, in.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryCatalog, err := newMasterCategoryCatalog(ctx, u.categoryRepository)
+	if err != nil {
+		return nil, err
+	}
+
+	shoppingItem, err := u.newShoppingItem(
+		ctx,
+		in.ID,
+		group.ID,
+		currentShoppingItem.PostedUserID(),
+		currentShoppingItem.Completed(),
+		currentShoppingItem.TransactionID(),
+		in,
+		categoryCatalog,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.todoRepository.EditShoppingItem(ctx, shoppingItem); err != nil {
+		return nil, err
+	}
+
+	updatedShoppingItem, err := u.todoRepository.GetShoppingItem(ctx, group.ID, in.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toShoppingItemOutput(updatedShoppingItem, categoryCatalog), nil
+}
+
+// DeleteShoppingItem does not delete the transaction which the item has already been converted into.
+func (u *todoUsecase) DeleteShoppingItem(ctx context.Context, user *input.User, group *input.Group, in *input.ShoppingItem) error {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return apperror.NewFieldError("user_id", err)
+	}
+
+	if err := checkGroupMember(ctx, u.groupRepository, group.ID, userID); err != nil {
+		return err
+	}
+
+	if err := u.todoRepository.DeleteShoppingItem(ctx, group.ID, in.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (u *todoUsecase) ChangeShoppingItemCompletion(ctx context.Context, user *input.User, group *input.Group, in *input.Completion) (*output.ShoppingItem, error) {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return nil, apperror.NewFieldError("user_id", err)
+	}
+
+	if err := checkGroupMember(ctx, u.groupRepository, group.ID, userID); err != nil {
+		return nil, err
+	}
+
+	shoppingItem, err := u.todoRepository.GetShoppingItem(ctx, group.ID, in.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if shoppingItem.IsPurchased() && !in.Completed {
+		return nil, apperror.NewFailedPreconditionError(apperror.ReasonShoppingItemAlreadyPurchased, "purchased shopping item cannot be uncompleted: %d", in.ID)
+	}
+
+	shoppingItem.SetCompleted(in.Completed)
+	if err := u.todoRepository.EditShoppingItem(ctx, shoppingItem); err != nil {
+		return nil, err
+	}
+
+	categoryCatalog, err := newMasterCategoryCatalog(ctx, u.categoryRepository)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedShoppingItem, err := u.todoRepository.GetShoppingItem(ctx, group.ID, in.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toShoppingItemOutput(updatedShoppingItem, categoryCatalog), nil
+}
+
+// ListShoppingItems lists the shopping items expected to be purchased in the month or on the date.
+func (u *todoUsecase) ListShoppingItems(ctx context.Context, user *input.User, group *input.Group, in *input.Period) (*output.ShoppingItems, error) {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return nil, apperror.NewFieldError("user_id", err)
+	}
+
+	from, to, err := toPeriod(in)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkGroupMember(ctx, u.groupRepository, group.ID, userID); err != nil {
+		return nil, err
+	}
+
+	shoppingItems, err := u.todoRepository.GetShoppingItems(ctx, group.ID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryCatalog, err := newMasterCategoryCatalog(ctx, u.categoryRepository)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &output.ShoppingItems{
+		ShoppingItems: make([]*output.ShoppingItem, len(shoppingItems)),
+	}
+
+	for i, shoppingItem := range shoppingItems {
+		out.ShoppingItems[i] = toShoppingItemOutput(shoppingItem, categoryCatalog)
+	}
+
+	return out, nil
+}
+
+// PurchaseShoppingItem completes the shopping item and converts it into an expense of the group paid by the user.
+// the amount of the input overrides the amount of the item, and the transaction date is today if not set.
+func (u *todoUsecase) PurchaseShoppingItem(ctx context.Context, user *input.User, group *input.Group, in *input.Purchase) (*output.ShoppingItem, error) {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return nil, apperror.NewFieldError("user_id", err)
+	}
+
+	if err := checkGroupMember(ctx, u.groupRepository, group.ID, userID); err != nil {
+		return nil, err
+	}
+
+	shoppingItem, err := u.todoRepository.GetShoppingItem(ctx, group.ID, in.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if shoppingItem.IsPurchased() {
+		return nil, apperror.NewFailedPreconditionError(apperror.ReasonShoppingItemAlreadyPurchased, "shopping item already purchased: %d", in.ID)
+	}
+
+	purchaseAmount := shoppingItem.Amount().Value()
+	if in.Amount != 0 {
+		purchaseAmount = in.Amount
+	}
+
+	amount, err := newTransactionAmount(purchaseAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	transactionDate := toDate(time.Now())
+	if in.TransactionDate != "" {
+		if transactionDate, err = time.Parse(dateLayout, in.TransactionDate); err != nil {
+			return nil, apperror.NewValidationError("transaction_date", "invalid transaction date: %s", in.TransactionDate)
+		}
+	}
+
+	// the transaction must never exist without the item linked to it, so that the item is never recorded twice.
+	if err := u.transactionManager.Transaction(ctx, func(ctx context.Context) error {
+		transactionID, err := u.transactionRepository.CreateGroupTransaction(ctx, shoppingItem.ToGroupTransaction(transactionDate, amount, userID))
+		if err != nil {
+			return err
+		}
+
+		return u.todoRepository.PurchaseShoppingItem(ctx, group.ID, in.ID, transactionID)
+	}); err != nil {
+		return nil, err
+	}
+
+	categoryCatalog, err := newMasterCategoryCatalog(ctx, u.categoryRepository)
+	if err != nil {
+		return nil, err
+	}
+
+	purchasedShoppingItem, err := u.todoRepository.GetShoppingItem(ctx, group.ID, in.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toShoppingItemOutput(purchasedShoppingItem, categoryCatalog), nil
+}
+
+func (u *todoUsecase) newTodo(ctx context.Context, id int, groupID int, postedUserID vo.UserID, completed bool, in *input.Todo) (*tododomain.Todo, error) {
+	implementationDate, err := time.Parse(dateLayout, in.ImplementationDate)
+	if err != nil {
+		return nil, apperror.NewValidationError("implementation_date", "invalid implementation date: %s", in.ImplementationDate)
+	}
+
+	dueDate, err := time.Parse(dateLayout, in.DueDate)
+	if err != nil {
+		return nil, apperror.NewValidationError("due_date", "invalid due date: %s", in.DueDate)
+	}
+
+	if implementationDate.After(dueDate) {
+		return nil, apperror.NewValidationError("due_date", "implementation date must be on or before due date: %s %s", in.ImplementationDate, in.DueDate)
+	}
+
+	content, err := vo.NewTodoContent(in.Content)
+	if err != nil {
+		return nil, apperror.NewFieldError("content", err)
+	}
+
+	assigneeUserID, err := u.newAssigneeUserID(ctx, groupID, in.AssigneeUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return tododomain.NewTodo(
+		id,
+		time.Time{},
+		time.Time{},
+		implementationDate,
+		dueDate,
+		content,
+		completed,
+		groupID,
+		postedUserID,
+		assigneeUserID,
+	), nil
+}
+
+// newShoppingItem validates the input and builds the shopping item. the category must be of expense,
+// since the item is converted into an expense when purchased.
+func (u *todoUsecase) newShoppingItem(
+	ctx context.Context,
+	id int,
+	groupID int,
+	postedUserID vo.UserID,
+	completed bool,
+	transactionID int,
+	in *input.ShoppingItem,
+	categoryCatalog *categoryCatalog,
+) (*tododomain.ShoppingItem, error) {
+	expectedPurchaseDate, err := time.Parse(dateLayout, in.ExpectedPurchaseDate)
+	if err != nil {
+		return nil, apperror.NewValidationError("expected_purchase_date", "invalid expected purchase date: %s", in.ExpectedPurchaseDate)
+	}
+
+	name, err := vo.NewShoppingItemName(in.Name)
+	if err != nil {
+		return nil, apperror.NewFieldError("name", err)
+	}
+
+	shop, err := vo.NewShop(in.Shop)
+	if err != nil {
+		return nil, apperror.NewFieldError("shop", err)
+	}
+
+	amount, err := vo.NewAmount(in.Amount)
+	if err != nil {
+		return nil, apperror.NewFieldError("amount", err)
+	}
+
+	bigCategoryID, mediumCategoryID, _, err := newCategoryIDs(in.BigCategoryID, in.MediumCategoryID, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := categoryCatalog.validate(vo.TransactionTypeExpense, bigCategoryID, mediumCategoryID, 0); err != nil {
+		return nil, err
+	}
+
+	assigneeUserID, err := u.newAssigneeUserID(ctx, groupID, in.AssigneeUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	return tododomain.NewShoppingItem(
+		id,
+		time.Time{},
+		time.Time{},
+		expectedPurchaseDate,
+		name,
+		shop,
+		amount,
+		completed,
+		groupID,
+		postedUserID,
+		assigneeUserID,
+		bigCategoryID,
+		mediumCategoryID,
+		transactionID,
+	), nil
+}
+
+// newAssigneeUserID returns the empty user id if nobody is assigned. the assignee must be a member of the group.
+func (u *todoUsecase) newAssigneeUserID(ctx context.Context, groupID int, assigneeUserID string) (vo.UserID, error) {
+	if assigneeUserID == "" {
+		return "", nil
+	}
+
+	userID, err := vo.NewUserID(assigneeUserID)
+	if err != nil {
+		return "", apperror.NewFieldError("assignee_user_id", err)
+	}
+
+	if isMember, err := u.groupRepository.IsApprovedUser(ctx, groupID, userID); err != nil {
+		return "", err
+	} else if !isMember {
+		return "", apperror.NewValidationError("assignee_user_id", "assignee does not belong to the group: %d %s", groupID, userID)
+	}
+
+	return userID, nil
+}
+
+// toPeriod returns the first and the last date of the month or the date, either of which must be set.
+func toPeriod(in *input.Period) (time.Time, time.Time, error) {
+	switch {
+	case in.YearMonth != "" && in.Date != "":
+		return time.Time{}, time.Time{}, apperror.NewValidationError("years_months", "years months and date cannot be set at the same time")
+	case in.YearMonth != "":
+		yearMonth, err := vo.NewYearMonth(in.YearMonth)
+		if err != nil {
+			return time.Time{}, time.Time{}, apperror.NewFieldError("years_months", err)
+		}
+
+		return yearMonth.Value(), yearMonth.LastDay(), nil
+	case in.Date != "":
+		date, err := time.Parse(dateLayout, in.Date)
+		if err != nil {
+			return time.Time{}, time.Time{}, apperror.NewValidationError("date", "invalid date: %s", in.Date)
+		}
+
+		return date, date, nil
+	default:
+		return time.Time{}, time.Time{}, apperror.NewValidationError("years_months", "either years months or date must be set")
+	}
+}
+
+func toTodoOutput(todo *tododomain.Todo) *output.Todo {
+	return &output.Todo{
+		ID:                 todo.ID(),
+		PostedDate:         todo.PostedDate().Format(datetimeLayout),
+		UpdatedDate:        todo.UpdatedDate().Format(datetimeLayout),
+		ImplementationDate: todo.ImplementationDate().Format(dateLayout),
+		DueDate:            todo.DueDate().Format(dateLayout),
+		Content:            todo.Content().Value(),
+		Completed:          todo.Completed(),
+		PostedUserID:       todo.PostedUserID().Value(),
+		AssigneeUserID:     todo.AssigneeUserID().Value(),
+	}
+}
+
+func toShoppingItemOutput(shoppingItem *tododomain.ShoppingItem, categoryCatalog *categoryCatalog) *output.ShoppingItem {
+	return &output.ShoppingItem{
+		ID:                   shoppingItem.ID(),
+		PostedDate:           shoppingItem.PostedDate().Format(datetimeLayout),
+		UpdatedDate:          shoppingItem.UpdatedDate().Format(datetimeLayout),
+		ExpectedPurchaseDate: shoppingItem.ExpectedPurchaseDate().Format(dateLayout),
+		Name:                 shoppingItem.Name().Value(),
+		Shop:                 shoppingItem.Shop().Value(),
+		Amount:               shoppingItem.Amount().Value(),
+		Completed:            shoppingItem.Completed(),
+		PostedUserID:         shoppingItem.PostedUserID().Value(),
+		AssigneeUserID:       shoppingItem.AssigneeUserID().Value(),
+		BigCategoryID:        shoppingItem.BigCategoryID().Value(),
+		BigCategoryName:      categoryCatalog.bigCategoryName(shoppingItem.BigCategoryID()),
+		MediumCategoryID:     shoppingItem.MediumCategoryID().Value(),
+		MediumCategoryName:   categoryCatalog.mediumCategoryName(shoppingItem.MediumCategoryID()),
+		TransactionID:        shoppingItem.TransactionID(),
+	}
+}
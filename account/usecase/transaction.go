@@ -0,0 +1,578 @@
+package usecase
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"github.com/paypay3/tukecholl-api/account/apperror"
+	"github.com/paypay3/tukecholl-api/account/domain/categorydomain"
+	"github.com/paypay3/tukecholl-api/account/domain/groupdomain"
+	"github.com/paypay3/tukecholl-api/account/domain/transactiondomain"
+	"github.com/paypay3/tukecholl-api/account/domain/vo"
+	"github.com/paypay3/tukecholl-api/account/usecase/input"
+	"github.com/paypay3/tukecholl-api/account/usecase/output"
+)
+
+const (
+	dateLayout     = "2006-01-02"
+	datetimeLayout = "2006-01-02 15:04:05"
+)
+
+const (
+	defaultSearchTransactionsLimit = 100
+	maxSearchTransactionsLimit     = 1000
+	maxSearchKeywordLength         = 50
+)
+
+type TransactionUsecase interface {
+	PostTransaction(ctx context.Context, user *input.User, in *input.Transaction) (*output.Transaction, error)
+	EditTransaction(ctx context.Context, user *input.User, in *input.Transaction) (*output.Transaction, error)
+	DeleteTransaction(ctx context.Context, user *input.User, in *input.Transaction) error
+	ListTransactions(ctx context.Context, user *input.User, in *input.Transactions) (*output.Transactions, error)
+	SearchTransactions(ctx context.Context, user *input.User, in *input.SearchTransactions) (*output.Transactions, error)
+	PostGroupTransaction(ctx context.Context, user *input.User, group *input.Group, in *input.GroupTransaction) (*output.GroupTransaction, error)
+	EditGroupTransaction(ctx context.Context, user *input.User, group *input.Group, in *input.GroupTransaction) (*output.GroupTransaction, error)
+	DeleteGroupTransaction(ctx context.Context, user *input.User, group *input.Group, in *input.GroupTransaction) error
+	ListGroupTransactions(ctx context.Context, user *input.User, group *input.Group, in *input.Transactions) (*output.GroupTransactions, error)
+}
+
+type transactionUsecase struct {
+	transactionRepository transactiondomain.Repository
+	categoryRepository    categorydomain.Repository
+	groupRepository       groupdomain.Repository
+}
+
+func NewTransactionUsecase(transactionRepository transactiondomain.Repository, categoryRepository categorydomain.Repository, groupRepository groupdomain.Repository) *transactionUsecase {
+	return &transactionUsecase{
+		transactionRepository: transactionRepository,
+		categoryRepository:    categoryRepository,
+		groupRepository:       groupRepository,
+	}
+}
+
+func (u *transactionUsecase) PostTransaction(ctx context.Context, user *input.User, in *input.Transaction) (*output.Transaction, error) {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return nil, apperror.NewFieldError("user_id", err)
+	}
+
+	categoryCatalog, err := newCategoryCatalog(ctx, u.categoryRepository, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction, err := newTransaction(0, userID, in, categoryCatalog)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := u.transactionRepository.CreateTransaction(ctx, transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	createdTransaction, err := u.transactionRepository.GetTransaction(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toTransactionOutput(createdTransaction, categoryCatalog), nil
+}
+
+func (u *transactionUsecase) EditTransaction(ctx context.Context, user *input.User, in *input.Transaction) (*output.Transaction, error) {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return nil, apperror.NewFieldError("user_id", err)
+	}
+
+	if _, err := u.transactionRepository.GetTransaction(ctx, userID, in.ID); err != nil {
+		return nil, err
+	}
+
+	categoryCatalog, err := newCategoryCatalog(ctx, u.categoryRepository, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction, err := newTransaction(in.ID, userID, in, categoryCatalog)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.transactionRepository.EditTransaction(ctx, transaction); err != nil {
+		return nil, err
+	}
+
+	updatedTransaction, err := u.transactionRepository.GetTransaction(ctx, userID, in.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toTransactionOutput(updatedTransaction, categoryCatalog), nil
+}
+
+func (u *transactionUsecase) DeleteTransaction(ctx context.Context, user *input.User, in *input.Transaction) error {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return apperror.NewFieldError("user_id", err)
+	}
+
+	if err := u.transactionRepository.DeleteTransaction(ctx, userID, in.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (u *transactionUsecase) ListTransactions(ctx context.Context, user *input.User, in *input.Transactions) (*output.Transactions, error) {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return nil, apperror.NewFieldError("user_id", err)
+	}
+
+	yearMonth, err := vo.NewYearMonth(in.YearMonth)
+	if err != nil {
+		return nil, apperror.NewFieldError("years_months", err)
+	}
+
+	transactions, err := u.transactionRepository.GetMonthlyTransactions(ctx, userID, yearMonth)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryCatalog, err := newCategoryCatalog(ctx, u.categoryRepository, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toTransactionsOutput(transactions, categoryCatalog), nil
+}
+
+func (u *transactionUsecase) SearchTransactions(ctx context.Context, user *input.User, in *input.SearchTransactions) (*output.Transactions, error) {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return nil, apperror.NewFieldError("user_id", err)
+	}
+
+	condition, err := newSearchCondition(userID, in)
+	if err != nil {
+		return nil, err
+	}
+
+	transactions, err := u.transactionRepository.SearchTransactions(ctx, condition)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryCatalog, err := newCategoryCatalog(ctx, u.categoryRepository, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toTransactionsOutput(transactions, categoryCatalog), nil
+}
+
+// PostGroupTransaction records a transaction of the group. only the members of the group can post it,
+// and the payment user must also be a member.
+func (u *transactionUsecase) PostGroupTransaction(ctx context.Context, user *input.User, group *input.Group, in *input.GroupTransaction) (*output.GroupTransaction, error) {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return nil, apperror.NewFieldError("user_id", err)
+	}
+
+	if err := checkGroupMember(ctx, u.groupRepository, group.ID, userID); err != nil {
+		return nil, err
+	}
+
+	categoryCatalog, err := newMasterCategoryCatalog(ctx, u.categoryRepository)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction, err := u.newGroupTransaction(ctx, 0, group.ID, userID, "", in, categoryCatalog)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := u.transactionRepository.CreateGroupTransaction(ctx, transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	createdTransaction, err := u.transactionRepository.GetGroupTransaction(ctx, group.ID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGroupTransactionOutput(createdTransaction, categoryCatalog), nil
+}
+
+func (u *transactionUsecase) EditGroupTransaction(ctx context.Context, user *input.User, group *input.Group, in *input.GroupTransaction) (*output.GroupTransaction, error) {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return nil, apperror.NewFieldError("user_id", err)
+	}
+
+	if err := checkGroupMember(ctx, u.groupRepository, group.ID, userID); err != nil {
+		return nil, err
+	}
+
+	currentTransaction, err := u.transactionRepository.GetGroupTransaction(ctx, group.ID, in.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryCatalog, err := newMasterCategoryCatalog(ctx, u.categoryRepository)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction, err := u.newGroupTransaction(ctx, in.ID, group.ID, currentTransaction.PostedUserID(), userID, in, categoryCatalog)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.transactionRepository.EditGroupTransaction(ctx, transaction); err != nil {
+		return nil, err
+	}
+
+	updatedTransaction, err := u.transactionRepository.GetGroupTransaction(ctx, group.ID, in.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return toGroupTransactionOutput(updatedTransaction, categoryCatalog), nil
+}
+
+func (u *transactionUsecase) DeleteGroupTransaction(ctx context.Context, user *input.User, group *input.Group, in *input.GroupTransaction) error {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return apperror.NewFieldError("user_id", err)
+	}
+
+	if err := checkGroupMember(ctx, u.groupRepository, group.ID, userID); err != nil {
+		return err
+	}
+
+	if err := u.transactionRepository.DeleteGroupTransaction(ctx, group.ID, in.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (u *transactionUsecase) ListGroupTransactions(ctx context.Context, user *input.User, group *input.Group, in *input.Transactions) (*output.GroupTransactions, error) {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return nil, apperror.NewFieldError("user_id", err)
+	}
+
+	yearMonth, err := vo.NewYearMonth(in.YearMonth)
+	if err != nil {
+		return nil, apperror.NewFieldError("years_months", err)
+	}
+
+	if err := checkGroupMember(ctx, u.groupRepository, group.ID, userID); err != nil {
+		return nil, err
+	}
+
+	transactions, err := u.transactionRepository.GetMonthlyGroupTransactions(ctx, group.ID, yearMonth)
+	if err != nil {
+		return nil, err
+	}
+
+	categoryCatalog, err := newMasterCategoryCatalog(ctx, u.categoryRepository)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &output.GroupTransactions{
+		Transactions: make([]*output.GroupTransaction, len(transactions)),
+	}
+
+	for i, transaction := range transactions {
+		out.Transactions[i] = toGroupTransactionOutput(transaction, categoryCatalog)
+	}
+
+	return out, nil
+}
+
+// newGroupTransaction validates the input in the same way as the transactions of users, and builds the transaction of the group.
+// the payment user must be a member of the group.
+func (u *transactionUsecase) newGroupTransaction(
+	ctx context.Context,
+	id int,
+	groupID int,
+	postedUserID vo.UserID,
+	updatedUserID vo.UserID,
+	in *input.GroupTransaction,
+	categoryCatalog *categoryCatalog,
+) (*transactiondomain.GroupTransaction, error) {
+	paymentUserID, err := vo.NewUserID(in.PaymentUserID)
+	if err != nil {
+		return nil, apperror.NewFieldError("payment_user_id", err)
+	}
+
+	if isMember, err := u.groupRepository.IsApprovedUser(ctx, groupID, paymentUserID); err != nil {
+		return nil, err
+	} else if !isMember {
+		return nil, apperror.NewValidationError("payment_user_id", "payment user does not belong to the group: %d %s", groupID, paymentUserID)
+	}
+
+	transaction, err := newTransaction(id, postedUserID, &input.Transaction{
+		TransactionType:  in.TransactionType,
+		TransactionDate:  in.TransactionDate,
+		Shop:             in.Shop,
+		Memo:             in.Memo,
+		Amount:           in.Amount,
+		BigCategoryID:    in.BigCategoryID,
+		MediumCategoryID: in.MediumCategoryID,
+	}, categoryCatalog)
+	if err != nil {
+		return nil, err
+	}
+
+	return transactiondomain.NewGroupTransaction(
+		id,
+		transaction.TransactionType(),
+		time.Time{},
+		time.Time{},
+		transaction.TransactionDate(),
+		transaction.Shop(),
+		transaction.Memo(),
+		transaction.Amount(),
+		groupID,
+		postedUserID,
+		updatedUserID,
+		paymentUserID,
+		transaction.BigCategoryID(),
+		transaction.MediumCategoryID(),
+	), nil
+}
+
+func newSearchCondition(userID vo.UserID, in *input.SearchTransactions) (*transactiondomain.SearchCondition, error) {
+	condition := &transactiondomain.SearchCondition{
+		UserID:  userID,
+		Keyword: in.Keyword,
+		Limit:   in.Limit,
+	}
+
+	if in.TransactionType != 0 {
+		transactionType, err := vo.NewTransactionType(in.TransactionType)
+		if err != nil {
+			return nil, apperror.NewFieldError("transaction_type", err)
+		}
+
+		condition.TransactionType = transactionType
+	}
+
+	if in.StartDate != "" {
+		startDate, err := time.Parse(dateLayout, in.StartDate)
+		if err != nil {
+			return nil, apperror.NewValidationError("start_date", "invalid start date: %s", in.StartDate)
+		}
+
+		condition.StartDate = startDate
+	}
+
+	if in.EndDate != "" {
+		endDate, err := time.Parse(dateLayout, in.EndDate)
+		if err != nil {
+			return nil, apperror.NewValidationError("end_date", "invalid end date: %s", in.EndDate)
+		}
+
+		condition.EndDate = endDate
+	}
+
+	if !condition.StartDate.IsZero() && !condition.EndDate.IsZero() && condition.StartDate.After(condition.EndDate) {
+		return nil, apperror.NewValidationError("start_date", "start date must be on or before end date: %s %s", in.StartDate, in.EndDate)
+	}
+
+	if in.BigCategoryID != 0 {
+		bigCategoryID, err := vo.NewBigCategoryID(in.BigCategoryID)
+		if err != nil {
+			return nil, apperror.NewFieldError("big_category_id", err)
+		}
+
+		condition.BigCategoryID = bigCategoryID
+	}
+
+	mediumCategoryID, err := vo.NewMediumCategoryID(in.MediumCategoryID)
+	if err != nil {
+		return nil, apperror.NewFieldError("medium_category_id", err)
+	}
+	condition.MediumCategoryID = mediumCategoryID
+
+	customCategoryID, err := vo.NewCustomCategoryID(in.CustomCategoryID)
+	if err != nil {
+		return nil, apperror.NewFieldError("custom_category_id", err)
+	}
+	condition.CustomCategoryID = customCategoryID
+
+	lowAmount, err := vo.NewAmount(in.LowAmount)
+	if err != nil {
+		return nil, apperror.NewFieldError("low_amount", err)
+	}
+	condition.LowAmount = lowAmount
+
+	highAmount, err := vo.NewAmount(in.HighAmount)
+	if err != nil {
+		return nil, apperror.NewFieldError("high_amount", err)
+	}
+	condition.HighAmount = highAmount
+
+	if condition.HighAmount != 0 && condition.LowAmount > condition.HighAmount {
+		return nil, apperror.NewValidationError("low_amount", "low amount must be less than or equal to high amount: %d %d", condition.LowAmount, condition.HighAmount)
+	}
+
+	if n := utf8.RuneCountInString(condition.Keyword); n > maxSearchKeywordLength {
+		return nil, apperror.NewValidationError("keyword", "keyword must be %d or less: %s", maxSearchKeywordLength, condition.Keyword)
+	}
+
+	switch sortField := transactiondomain.SortField(in.SortField); sortField {
+	case 0:
+		condition.SortField = transactiondomain.SortFieldTransactionDate
+	case transactiondomain.SortFieldTransactionDate, transactiondomain.SortFieldAmount, transactiondomain.SortFieldUpdatedDate:
+		condition.SortField = sortField
+	default:
+		return nil, apperror.NewValidationError("sort_field", "invalid sort field: %d", in.SortField)
+	}
+
+	switch sortOrder := transactiondomain.SortOrder(in.SortOrder); sortOrder {
+	case 0:
+		condition.SortOrder = transactiondomain.SortOrderDesc
+	case transactiondomain.SortOrderAsc, transactiondomain.SortOrderDesc:
+		condition.SortOrder = sortOrder
+	default:
+		return nil, apperror.NewValidationError("sort_order", "invalid sort order: %d", in.SortOrder)
+	}
+
+	if condition.Limit == 0 {
+		condition.Limit = defaultSearchTransactionsLimit
+	}
+
+	if condition.Limit < 0 || condition.Limit > maxSearchTransactionsLimit {
+		return nil, apperror.NewValidationError("limit", "limit must be %d or less: %d", maxSearchTransactionsLimit, in.Limit)
+	}
+
+	return condition, nil
+}
+
+// newTransaction validates the input and builds the transaction of the user.
+// the posted and updated dates are left zero since they are set by the database.
+func newTransaction(id int, userID vo.UserID, in *input.Transaction, categoryCatalog *categoryCatalog) (*transactiondomain.Transaction, error) {
+	transactionType, err := vo.NewTransactionType(in.TransactionType)
+	if err != nil {
+		return nil, apperror.NewFieldError("transaction_type", err)
+	}
+
+	transactionDate, err := time.Parse(dateLayout, in.TransactionDate)
+	if err != nil {
+		return nil, apperror.NewValidationError("transaction_date", "invalid transaction date: %s", in.TransactionDate)
+	}
+
+	shop, err := vo.NewShop(in.Shop)
+	if err != nil {
+		return nil, apperror.NewFieldError("shop", err)
+	}
+
+	memo, err := vo.NewMemo(in.Memo)
+	if err != nil {
+		return nil, apperror.NewFieldError("memo", err)
+	}
+
+	amount, err := newTransactionAmount(in.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	bigCategoryID, mediumCategoryID, customCategoryID, err := newCategoryIDs(in.BigCategoryID, in.MediumCategoryID, in.CustomCategoryID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := categoryCatalog.validate(transactionType, bigCategoryID, mediumCategoryID, customCategoryID); err != nil {
+		return nil, err
+	}
+
+	return transactiondomain.NewTransaction(
+		id,
+		transactionType,
+		time.Time{},
+		time.Time{},
+		transactionDate,
+		shop,
+		memo,
+		amount,
+		userID,
+		bigCategoryID,
+		mediumCategoryID,
+		customCategoryID,
+	), nil
+}
+
+// newTransactionAmount validates the amount of a transaction, which must be positive unlike vo.Amount.
+func newTransactionAmount(amount int) (vo.Amount, error) {
+	transactionAmount, err := vo.NewAmount(amount)
+	if err != nil {
+		return 0, apperror.NewFieldError("amount", err)
+	}
+
+	if transactionAmount == 0 {
+		return 0, apperror.NewValidationError("amount", "invalid amount: amount must be positive")
+	}
+
+	return transactionAmount, nil
+}
+
+func toTransactionOutput(transaction *transactiondomain.Transaction, categoryCatalog *categoryCatalog) *output.Transaction {
+	return &output.Transaction{
+		ID:                 transaction.ID(),
+		TransactionType:    output.TransactionType(transaction.TransactionType().Value()),
+		PostedDate:         transaction.PostedDate().Format(datetimeLayout),
+		UpdatedDate:        transaction.UpdatedDate().Format(datetimeLayout),
+		TransactionDate:    transaction.TransactionDate().Format(dateLayout),
+		Shop:               transaction.Shop().Value(),
+		Memo:               transaction.Memo().Value(),
+		Amount:             transaction.Amount().Value(),
+		BigCategoryID:      transaction.BigCategoryID().Value(),
+		BigCategoryName:    categoryCatalog.bigCategoryName(transaction.BigCategoryID()),
+		MediumCategoryID:   transaction.MediumCategoryID().Value(),
+		MediumCategoryName: categoryCatalog.mediumCategoryName(transaction.MediumCategoryID()),
+		CustomCategoryID:   transaction.CustomCategoryID().Value(),
+		CustomCategoryName: categoryCatalog.customCategoryName(transaction.CustomCategoryID()),
+	}
+}
+
+func toTransactionsOutput(transactions []*transactiondomain.Transaction, categoryCatalog *categoryCatalog) *output.Transactions {
+	out := &output.Transactions{
+		Transactions: make([]*output.Transaction, len(transactions)),
+	}
+
+	for i, transaction := range transactions {
+		out.Transactions[i] = toTransactionOutput(transaction, categoryCatalog)
+	}
+
+	return out
+}
+
+func toGroupTransactionOutput(transaction *transactiondomain.GroupTransaction, categoryCatalog *categoryCatalog) *output.GroupTransaction {
+	return &output.GroupTransaction{
+		ID:                 transaction.ID(),
+		TransactionType:    output.TransactionType(transaction.TransactionType().Value()),
+		PostedDate:         transaction.PostedDate().Format(datetimeLayout),
+		UpdatedDate:        transaction.UpdatedDate().Format(datetimeLayout),
+		TransactionDate:    transaction.TransactionDate().Format(dateLayout),
+		Shop:               transaction.Shop().Value(),
+		Memo:               transaction.Memo().Value(),
+		Amount:             transaction.Amount().Value(),
+		PostedUserID:       transaction.PostedUserID().Value(),
+		UpdatedUserID:      transaction.UpdatedUserID().Value(),
+		PaymentUserID:      transaction.PaymentUserID().Value(),
+		BigCategoryID:      transaction.BigCategoryID().Value(),
+		BigCategoryName:    categoryCatalog.bigCategoryName(transaction.BigCategoryID()),
+		MediumCategoryID:   transaction.MediumCategoryID().Value(),
+		MediumCategoryName: categoryCatalog.mediumCategoryName(transaction.MediumCategoryID()),
+	}
+}
@@ -0,0 +1,9 @@
+package usecase
+
+import "context"
+
+// TransactionManager runs fn in a transaction shared by the repositories called with the ctx passed to fn.
+// The transaction is committed if fn returns nil and rolled back otherwise.
+type TransactionManager interface {
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,90 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/paypay3/tukecholl-api/account/apperror"
+	"github.com/paypay3/tukecholl-api/account/domain/budgetdomain"
+	"github.com/paypay3/tukecholl-api/account/domain/categorydomain"
+	"github.com/paypay3/tukecholl-api/account/domain/userdomain"
+	"github.com/paypay3/tukecholl-api/account/domain/vo"
+	"github.com/paypay3/tukecholl-api/account/usecase/input"
+	"github.com/paypay3/tukecholl-api/account/usecase/output"
+)
+
+type UserUsecase interface {
+	CreateUser(ctx context.Context, user *input.User) (*output.User, error)
+}
+
+type userUsecase struct {
+	transactionManager TransactionManager
+	userRepository     userdomain.Repository
+	budgetRepository   budgetdomain.Repository
+	categoryRepository categorydomain.Repository
+}
+
+func NewUserUsecase(transactionManager TransactionManager, userRepository userdomain.Repository, budgetRepository budgetdomain.Repository, categoryRepository categorydomain.Repository) *userUsecase {
+	return &userUsecase{
+		transactionManager: transactionManager,
+		userRepository:     userRepository,
+		budgetRepository:   budgetRepository,
+		categoryRepository: categoryRepository,
+	}
+}
+
+func (u *userUsecase) CreateUser(ctx context.Context, user *input.User) (*output.User, error) {
+	userID, err := vo.NewUserID(user.ID)
+	if err != nil {
+		return nil, apperror.NewFieldError("id", err)
+	}
+
+	name, err := vo.NewUserName(user.Name)
+	if err != nil {
+		return nil, apperror.NewFieldError("name", err)
+	}
+
+	email, err := vo.NewEmail(user.Email)
+	if err != nil {
+		return nil, apperror.NewFieldError("email", err)
+	}
+
+	password, err := vo.NewPassword(user.Password)
+	if err != nil {
+		return nil, apperror.NewFieldError("password", err)
+	}
+
+	if exists, err := u.userRepository.ExistsUserID(ctx, userID); err != nil {
+		return nil, err
+	} else if exists {
+		return nil, apperror.NewConflictError(apperror.ReasonUserIDAlreadyExists, "user id already exists: %s", userID)
+	}
+
+	if exists, err := u.userRepository.ExistsEmail(ctx, email); err != nil {
+		return nil, err
+	} else if exists {
+		return nil, apperror.NewConflictError(apperror.ReasonEmailAlreadyExists, "email already exists: %s", email)
+	}
+
+	bigCategoryIDs, err := getBudgetableBigCategoryIDs(ctx, u.categoryRepository)
+	if err != nil {
+		return nil, err
+	}
+
+	newUser := userdomain.NewUser(userID, name, email, password)
+	// the user must never exist without the standard budgets.
+	if err := u.transactionManager.Transaction(ctx, func(ctx context.Context) error {
+		if err := u.userRepository.CreateUser(ctx, newUser); err != nil {
+			return err
+		}
+
+		return u.budgetRepository.CreateStandardBudgets(ctx, newUser.ID(), bigCategoryIDs)
+	}); err != nil {
+		return nil, err
+	}
+
+	return &output.User{
+		ID:    newUser.ID().Value(),
+		Name:  newUser.Name().Value(),
+		Email: newUser.Email().Value(),
+	}, nil
+}
@@ -19,6 +19,17 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type BudgetServiceClient interface {
 	CreateStandardBudgets(ctx context.Context, in *CreateStandardBudgetsRequest, opts ...grpc.CallOption) (*CreateStandardBudgetsResponse, error)
+	GetStandardBudgets(ctx context.Context, in *GetStandardBudgetsRequest, opts ...grpc.CallOption) (*GetStandardBudgetsResponse, error)
+	EditStandardBudgets(ctx context.Context, in *EditStandardBudgetsRequest, opts ...grpc.CallOption) (*EditStandardBudgetsResponse, error)
+	CreateCustomBudgets(ctx context.Context, in *CreateCustomBudgetsRequest, opts ...grpc.CallOption) (*CreateCustomBudgetsResponse, error)
+	GetCustomBudgets(ctx context.Context, in *GetCustomBudgetsRequest, opts ...grpc.CallOption) (*GetCustomBudgetsResponse, error)
+	EditCustomBudgets(ctx context.Context, in *EditCustomBudgetsRequest, opts ...grpc.CallOption) (*EditCustomBudgetsResponse, error)
+	DeleteCustomBudgets(ctx context.Context, in *DeleteCustomBudgetsRequest, opts ...grpc.CallOption) (*DeleteCustomBudgetsResponse, error)
+	GetYearlyBudget(ctx context.Context, in *GetYearlyBudgetRequest, opts ...grpc.CallOption) (*GetYearlyBudgetResponse, error)
+	GetBudgetStatus(ctx context.Context, in *GetBudgetStatusRequest, opts ...grpc.CallOption) (*GetBudgetStatusResponse, error)
+	CreateGroupStandardBudgets(ctx context.Context, in *CreateGroupStandardBudgetsRequest, opts ...grpc.CallOption) (*CreateGroupStandardBudgetsResponse, error)
+	GetGroupStandardBudgets(ctx context.Context, in *GetGroupStandardBudgetsRequest, opts ...grpc.CallOption) (*GetGroupStandardBudgetsResponse, error)
+	EditGroupStandardBudgets(ctx context.Context, in *EditGroupStandardBudgetsRequest, opts ...grpc.CallOption) (*EditGroupStandardBudgetsResponse, error)
 }
 
 type budgetServiceClient struct {
@@ -38,11 +49,121 @@ func (c *budgetServiceClient) CreateStandardBudgets(ctx context.Context, in *Cre
 	return out, nil
 }
 
+func (c *budgetServiceClient) GetStandardBudgets(ctx context.Context, in *GetStandardBudgetsRequest, opts ...grpc.CallOption) (*GetStandardBudgetsResponse, error) {
+	out := new(GetStandardBudgetsResponse)
+	err := c.cc.Invoke(ctx, "/account.BudgetService/GetStandardBudgets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) EditStandardBudgets(ctx context.Context, in *EditStandardBudgetsRequest, opts ...grpc.CallOption) (*EditStandardBudgetsResponse, error) {
+	out := new(EditStandardBudgetsResponse)
+	err := c.cc.Invoke(ctx, "/account.BudgetService/EditStandardBudgets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) CreateCustomBudgets(ctx context.Context, in *CreateCustomBudgetsRequest, opts ...grpc.CallOption) (*CreateCustomBudgetsResponse, error) {
+	out := new(CreateCustomBudgetsResponse)
+	err := c.cc.Invoke(ctx, "/account.BudgetService/CreateCustomBudgets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) GetCustomBudgets(ctx context.Context, in *GetCustomBudgetsRequest, opts ...grpc.CallOption) (*GetCustomBudgetsResponse, error) {
+	out := new(GetCustomBudgetsResponse)
+	err := c.cc.Invoke(ctx, "/account.BudgetService/GetCustomBudgets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) EditCustomBudgets(ctx context.Context, in *EditCustomBudgetsRequest, opts ...grpc.CallOption) (*EditCustomBudgetsResponse, error) {
+	out := new(EditCustomBudgetsResponse)
+	err := c.cc.Invoke(ctx, "/account.BudgetService/EditCustomBudgets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) DeleteCustomBudgets(ctx context.Context, in *DeleteCustomBudgetsRequest, opts ...grpc.CallOption) (*DeleteCustomBudgetsResponse, error) {
+	out := new(DeleteCustomBudgetsResponse)
+	err := c.cc.Invoke(ctx, "/account.BudgetService/DeleteCustomBudgets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) GetYearlyBudget(ctx context.Context, in *GetYearlyBudgetRequest, opts ...grpc.CallOption) (*GetYearlyBudgetResponse, error) {
+	out := new(GetYearlyBudgetResponse)
+	err := c.cc.Invoke(ctx, "/account.BudgetService/GetYearlyBudget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) GetBudgetStatus(ctx context.Context, in *GetBudgetStatusRequest, opts ...grpc.CallOption) (*GetBudgetStatusResponse, error) {
+	out := new(GetBudgetStatusResponse)
+	err := c.cc.Invoke(ctx, "/account.BudgetService/GetBudgetStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) CreateGroupStandardBudgets(ctx context.Context, in *CreateGroupStandardBudgetsRequest, opts ...grpc.CallOption) (*CreateGroupStandardBudgetsResponse, error) {
+	out := new(CreateGroupStandardBudgetsResponse)
+	err := c.cc.Invoke(ctx, "/account.BudgetService/CreateGroupStandardBudgets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) GetGroupStandardBudgets(ctx context.Context, in *GetGroupStandardBudgetsRequest, opts ...grpc.CallOption) (*GetGroupStandardBudgetsResponse, error) {
+	out := new(GetGroupStandardBudgetsResponse)
+	err := c.cc.Invoke(ctx, "/account.BudgetService/GetGroupStandardBudgets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *budgetServiceClient) EditGroupStandardBudgets(ctx context.Context, in *EditGroupStandardBudgetsRequest, opts ...grpc.CallOption) (*EditGroupStandardBudgetsResponse, error) {
+	out := new(EditGroupStandardBudgetsResponse)
+	err := c.cc.Invoke(ctx, "/account.BudgetService/EditGroupStandardBudgets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BudgetServiceServer is the server API for BudgetService service.
 // All implementations must embed UnimplementedBudgetServiceServer
 // for forward compatibility
 type BudgetServiceServer interface {
 	CreateStandardBudgets(context.Context, *CreateStandardBudgetsRequest) (*CreateStandardBudgetsResponse, error)
+	GetStandardBudgets(context.Context, *GetStandardBudgetsRequest) (*GetStandardBudgetsResponse, error)
+	EditStandardBudgets(context.Context, *EditStandardBudgetsRequest) (*EditStandardBudgetsResponse, error)
+	CreateCustomBudgets(context.Context, *CreateCustomBudgetsRequest) (*CreateCustomBudgetsResponse, error)
+	GetCustomBudgets(context.Context, *GetCustomBudgetsRequest) (*GetCustomBudgetsResponse, error)
+	EditCustomBudgets(context.Context, *EditCustomBudgetsRequest) (*EditCustomBudgetsResponse, error)
+	DeleteCustomBudgets(context.Context, *DeleteCustomBudgetsRequest) (*DeleteCustomBudgetsResponse, error)
+	GetYearlyBudget(context.Context, *GetYearlyBudgetRequest) (*GetYearlyBudgetResponse, error)
+	GetBudgetStatus(context.Context, *GetBudgetStatusRequest) (*GetBudgetStatusResponse, error)
+	CreateGroupStandardBudgets(context.Context, *CreateGroupStandardBudgetsRequest) (*CreateGroupStandardBudgetsResponse, error)
+	GetGroupStandardBudgets(context.Context, *GetGroupStandardBudgetsRequest) (*GetGroupStandardBudgetsResponse, error)
+	EditGroupStandardBudgets(context.Context, *EditGroupStandardBudgetsRequest) (*EditGroupStandardBudgetsResponse, error)
 	mustEmbedUnimplementedBudgetServiceServer()
 }
 
@@ -53,6 +174,39 @@ type UnimplementedBudgetServiceServer struct {
 func (UnimplementedBudgetServiceServer) CreateStandardBudgets(context.Context, *CreateStandardBudgetsRequest) (*CreateStandardBudgetsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateStandardBudgets not implemented")
 }
+func (UnimplementedBudgetServiceServer) GetStandardBudgets(context.Context, *GetStandardBudgetsRequest) (*GetStandardBudgetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStandardBudgets not implemented")
+}
+func (UnimplementedBudgetServiceServer) EditStandardBudgets(context.Context, *EditStandardBudgetsRequest) (*EditStandardBudgetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditStandardBudgets not implemented")
+}
+func (UnimplementedBudgetServiceServer) CreateCustomBudgets(context.Context, *CreateCustomBudgetsRequest) (*CreateCustomBudgetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCustomBudgets not implemented")
+}
+func (UnimplementedBudgetServiceServer) GetCustomBudgets(context.Context, *GetCustomBudgetsRequest) (*GetCustomBudgetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCustomBudgets not implemented")
+}
+func (UnimplementedBudgetServiceServer) EditCustomBudgets(context.Context, *EditCustomBudgetsRequest) (*EditCustomBudgetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditCustomBudgets not implemented")
+}
+func (UnimplementedBudgetServiceServer) DeleteCustomBudgets(context.Context, *DeleteCustomBudgetsRequest) (*DeleteCustomBudgetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCustomBudgets not implemented")
+}
+func (UnimplementedBudgetServiceServer) GetYearlyBudget(context.Context, *GetYearlyBudgetRequest) (*GetYearlyBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetYearlyBudget not implemented")
+}
+func (UnimplementedBudgetServiceServer) GetBudgetStatus(context.Context, *GetBudgetStatusRequest) (*GetBudgetStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBudgetStatus not implemented")
+}
+func (UnimplementedBudgetServiceServer) CreateGroupStandardBudgets(context.Context, *CreateGroupStandardBudgetsRequest) (*CreateGroupStandardBudgetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGroupStandardBudgets not implemented")
+}
+func (UnimplementedBudgetServiceServer) GetGroupStandardBudgets(context.Context, *GetGroupStandardBudgetsRequest) (*GetGroupStandardBudgetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGroupStandardBudgets not implemented")
+}
+func (UnimplementedBudgetServiceServer) EditGroupStandardBudgets(context.Context, *EditGroupStandardBudgetsRequest) (*EditGroupStandardBudgetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditGroupStandardBudgets not implemented")
+}
 func (UnimplementedBudgetServiceServer) mustEmbedUnimplementedBudgetServiceServer() {}
 
 // UnsafeBudgetServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -84,6 +238,204 @@ func _BudgetService_CreateStandardBudgets_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BudgetService_GetStandardBudgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStandardBudgetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).GetStandardBudgets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.BudgetService/GetStandardBudgets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).GetStandardBudgets(ctx, req.(*GetStandardBudgetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_EditStandardBudgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditStandardBudgetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).EditStandardBudgets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.BudgetService/EditStandardBudgets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).EditStandardBudgets(ctx, req.(*EditStandardBudgetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_CreateCustomBudgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCustomBudgetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).CreateCustomBudgets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.BudgetService/CreateCustomBudgets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).CreateCustomBudgets(ctx, req.(*CreateCustomBudgetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_GetCustomBudgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCustomBudgetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).GetCustomBudgets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.BudgetService/GetCustomBudgets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).GetCustomBudgets(ctx, req.(*GetCustomBudgetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_EditCustomBudgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditCustomBudgetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).EditCustomBudgets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.BudgetService/EditCustomBudgets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).EditCustomBudgets(ctx, req.(*EditCustomBudgetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_DeleteCustomBudgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCustomBudgetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).DeleteCustomBudgets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.BudgetService/DeleteCustomBudgets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).DeleteCustomBudgets(ctx, req.(*DeleteCustomBudgetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_GetYearlyBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetYearlyBudgetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).GetYearlyBudget(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.BudgetService/GetYearlyBudget",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).GetYearlyBudget(ctx, req.(*GetYearlyBudgetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_GetBudgetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBudgetStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).GetBudgetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.BudgetService/GetBudgetStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).GetBudgetStatus(ctx, req.(*GetBudgetStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_CreateGroupStandardBudgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupStandardBudgetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).CreateGroupStandardBudgets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.BudgetService/CreateGroupStandardBudgets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).CreateGroupStandardBudgets(ctx, req.(*CreateGroupStandardBudgetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_GetGroupStandardBudgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGroupStandardBudgetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).GetGroupStandardBudgets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.BudgetService/GetGroupStandardBudgets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).GetGroupStandardBudgets(ctx, req.(*GetGroupStandardBudgetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BudgetService_EditGroupStandardBudgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditGroupStandardBudgetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BudgetServiceServer).EditGroupStandardBudgets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.BudgetService/EditGroupStandardBudgets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BudgetServiceServer).EditGroupStandardBudgets(ctx, req.(*EditGroupStandardBudgetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BudgetService_ServiceDesc is the grpc.ServiceDesc for BudgetService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -95,6 +447,849 @@ var BudgetService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateStandardBudgets",
 			Handler:    _BudgetService_CreateStandardBudgets_Handler,
 		},
+		{
+			MethodName: "GetStandardBudgets",
+			Handler:    _BudgetService_GetStandardBudgets_Handler,
+		},
+		{
+			MethodName: "EditStandardBudgets",
+			Handler:    _BudgetService_EditStandardBudgets_Handler,
+		},
+		{
+			MethodName: "CreateCustomBudgets",
+			Handler:    _BudgetService_CreateCustomBudgets_Handler,
+		},
+		{
+			MethodName: "GetCustomBudgets",
+			Handler:    _BudgetService_GetCustomBudgets_Handler,
+		},
+		{
+			MethodName: "EditCustomBudgets",
+			Handler:    _BudgetService_EditCustomBudgets_Handler,
+		},
+		{
+			MethodName: "DeleteCustomBudgets",
+			Handler:    _BudgetService_DeleteCustomBudgets_Handler,
+		},
+		{
+			MethodName: "GetYearlyBudget",
+			Handler:    _BudgetService_GetYearlyBudget_Handler,
+		},
+		{
+			MethodName: "GetBudgetStatus",
+			Handler:    _BudgetService_GetBudgetStatus_Handler,
+		},
+		{
+			MethodName: "CreateGroupStandardBudgets",
+			Handler:    _BudgetService_CreateGroupStandardBudgets_Handler,
+		},
+		{
+			MethodName: "GetGroupStandardBudgets",
+			Handler:    _BudgetService_GetGroupStandardBudgets_Handler,
+		},
+		{
+			MethodName: "EditGroupStandardBudgets",
+			Handler:    _BudgetService_EditGroupStandardBudgets_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/accountproto/account.proto",
+}
+
+// CategoryServiceClient is the client API for CategoryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CategoryServiceClient interface {
+	ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
+	CreateCustomCategory(ctx context.Context, in *CreateCustomCategoryRequest, opts ...grpc.CallOption) (*CreateCustomCategoryResponse, error)
+	EditCustomCategory(ctx context.Context, in *EditCustomCategoryRequest, opts ...grpc.CallOption) (*EditCustomCategoryResponse, error)
+	DeleteCustomCategory(ctx context.Context, in *DeleteCustomCategoryRequest, opts ...grpc.CallOption) (*DeleteCustomCategoryResponse, error)
+}
+
+type categoryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCategoryServiceClient(cc grpc.ClientConnInterface) CategoryServiceClient {
+	return &categoryServiceClient{cc}
+}
+
+func (c *categoryServiceClient) ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error) {
+	out := new(ListCategoriesResponse)
+	err := c.cc.Invoke(ctx, "/account.CategoryService/ListCategories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryServiceClient) CreateCustomCategory(ctx context.Context, in *CreateCustomCategoryRequest, opts ...grpc.CallOption) (*CreateCustomCategoryResponse, error) {
+	out := new(CreateCustomCategoryResponse)
+	err := c.cc.Invoke(ctx, "/account.CategoryService/CreateCustomCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryServiceClient) EditCustomCategory(ctx context.Context, in *EditCustomCategoryRequest, opts ...grpc.CallOption) (*EditCustomCategoryResponse, error) {
+	out := new(EditCustomCategoryResponse)
+	err := c.cc.Invoke(ctx, "/account.CategoryService/EditCustomCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryServiceClient) DeleteCustomCategory(ctx context.Context, in *DeleteCustomCategoryRequest, opts ...grpc.CallOption) (*DeleteCustomCategoryResponse, error) {
+	out := new(DeleteCustomCategoryResponse)
+	err := c.cc.Invoke(ctx, "/account.CategoryService/DeleteCustomCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CategoryServiceServer is the server API for CategoryService service.
+// All implementations must embed UnimplementedCategoryServiceServer
+// for forward compatibility
+type CategoryServiceServer interface {
+	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error)
+	CreateCustomCategory(context.Context, *CreateCustomCategoryRequest) (*CreateCustomCategoryResponse, error)
+	EditCustomCategory(context.Context, *EditCustomCategoryRequest) (*EditCustomCategoryResponse, error)
+	DeleteCustomCategory(context.Context, *DeleteCustomCategoryRequest) (*DeleteCustomCategoryResponse, error)
+	mustEmbedUnimplementedCategoryServiceServer()
+}
+
+// UnimplementedCategoryServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedCategoryServiceServer struct {
+}
+
+func (UnimplementedCategoryServiceServer) ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategories not implemented")
+}
+func (UnimplementedCategoryServiceServer) CreateCustomCategory(context.Context, *CreateCustomCategoryRequest) (*CreateCustomCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCustomCategory not implemented")
+}
+func (UnimplementedCategoryServiceServer) EditCustomCategory(context.Context, *EditCustomCategoryRequest) (*EditCustomCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditCustomCategory not implemented")
+}
+func (UnimplementedCategoryServiceServer) DeleteCustomCategory(context.Context, *DeleteCustomCategoryRequest) (*DeleteCustomCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCustomCategory not implemented")
+}
+func (UnimplementedCategoryServiceServer) mustEmbedUnimplementedCategoryServiceServer() {}
+
+// UnsafeCategoryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CategoryServiceServer will
+// result in compilation errors.
+type UnsafeCategoryServiceServer interface {
+	mustEmbedUnimplementedCategoryServiceServer()
+}
+
+func RegisterCategoryServiceServer(s grpc.ServiceRegistrar, srv CategoryServiceServer) {
+	s.RegisterService(&CategoryService_ServiceDesc, srv)
+}
+
+func _CategoryService_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServiceServer).ListCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.CategoryService/ListCategories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServiceServer).ListCategories(ctx, req.(*ListCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CategoryService_CreateCustomCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCustomCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServiceServer).CreateCustomCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.CategoryService/CreateCustomCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServiceServer).CreateCustomCategory(ctx, req.(*CreateCustomCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CategoryService_EditCustomCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditCustomCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServiceServer).EditCustomCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.CategoryService/EditCustomCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServiceServer).EditCustomCategory(ctx, req.(*EditCustomCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CategoryService_DeleteCustomCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCustomCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServiceServer).DeleteCustomCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.CategoryService/DeleteCustomCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServiceServer).DeleteCustomCategory(ctx, req.(*DeleteCustomCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CategoryService_ServiceDesc is the grpc.ServiceDesc for CategoryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CategoryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "account.CategoryService",
+	HandlerType: (*CategoryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListCategories",
+			Handler:    _CategoryService_ListCategories_Handler,
+		},
+		{
+			MethodName: "CreateCustomCategory",
+			Handler:    _CategoryService_CreateCustomCategory_Handler,
+		},
+		{
+			MethodName: "EditCustomCategory",
+			Handler:    _CategoryService_EditCustomCategory_Handler,
+		},
+		{
+			MethodName: "DeleteCustomCategory",
+			Handler:    _CategoryService_DeleteCustomCategory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/accountproto/account.proto",
+}
+
+// TransactionServiceClient is the client API for TransactionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TransactionServiceClient interface {
+	PostTransaction(ctx context.Context, in *PostTransactionRequest, opts ...grpc.CallOption) (*PostTransactionResponse, error)
+	EditTransaction(ctx context.Context, in *EditTransactionRequest, opts ...grpc.CallOption) (*EditTransactionResponse, error)
+	DeleteTransaction(ctx context.Context, in *DeleteTransactionRequest, opts ...grpc.CallOption) (*DeleteTransactionResponse, error)
+	ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error)
+	SearchTransactions(ctx context.Context, in *SearchTransactionsRequest, opts ...grpc.CallOption) (*SearchTransactionsResponse, error)
+	PostGroupTransaction(ctx context.Context, in *PostGroupTransactionRequest, opts ...grpc.CallOption) (*PostGroupTransactionResponse, error)
+	EditGroupTransaction(ctx context.Context, in *EditGroupTransactionRequest, opts ...grpc.CallOption) (*EditGroupTransactionResponse, error)
+	DeleteGroupTransaction(ctx context.Context, in *DeleteGroupTransactionRequest, opts ...grpc.CallOption) (*DeleteGroupTransactionResponse, error)
+	ListGroupTransactions(ctx context.Context, in *ListGroupTransactionsRequest, opts ...grpc.CallOption) (*ListGroupTransactionsResponse, error)
+}
+
+type transactionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTransactionServiceClient(cc grpc.ClientConnInterface) TransactionServiceClient {
+	return &transactionServiceClient{cc}
+}
+
+func (c *transactionServiceClient) PostTransaction(ctx context.Context, in *PostTransactionRequest, opts ...grpc.CallOption) (*PostTransactionResponse, error) {
+	out := new(PostTransactionResponse)
+	err := c.cc.Invoke(ctx, "/account.TransactionService/PostTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) EditTransaction(ctx context.Context, in *EditTransactionRequest, opts ...grpc.CallOption) (*EditTransactionResponse, error) {
+	out := new(EditTransactionResponse)
+	err := c.cc.Invoke(ctx, "/account.TransactionService/EditTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) DeleteTransaction(ctx context.Context, in *DeleteTransactionRequest, opts ...grpc.CallOption) (*DeleteTransactionResponse, error) {
+	out := new(DeleteTransactionResponse)
+	err := c.cc.Invoke(ctx, "/account.TransactionService/DeleteTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ListTransactions(ctx context.Context, in *ListTransactionsRequest, opts ...grpc.CallOption) (*ListTransactionsResponse, error) {
+	out := new(ListTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/account.TransactionService/ListTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) SearchTransactions(ctx context.Context, in *SearchTransactionsRequest, opts ...grpc.CallOption) (*SearchTransactionsResponse, error) {
+	out := new(SearchTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/account.TransactionService/SearchTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) PostGroupTransaction(ctx context.Context, in *PostGroupTransactionRequest, opts ...grpc.CallOption) (*PostGroupTransactionResponse, error) {
+	out := new(PostGroupTransactionResponse)
+	err := c.cc.Invoke(ctx, "/account.TransactionService/PostGroupTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) EditGroupTransaction(ctx context.Context, in *EditGroupTransactionRequest, opts ...grpc.CallOption) (*EditGroupTransactionResponse, error) {
+	out := new(EditGroupTransactionResponse)
+	err := c.cc.Invoke(ctx, "/account.TransactionService/EditGroupTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) DeleteGroupTransaction(ctx context.Context, in *DeleteGroupTransactionRequest, opts ...grpc.CallOption) (*DeleteGroupTransactionResponse, error) {
+	out := new(DeleteGroupTransactionResponse)
+	err := c.cc.Invoke(ctx, "/account.TransactionService/DeleteGroupTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionServiceClient) ListGroupTransactions(ctx context.Context, in *ListGroupTransactionsRequest, opts ...grpc.CallOption) (*ListGroupTransactionsResponse, error) {
+	out := new(ListGroupTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/account.TransactionService/ListGroupTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TransactionServiceServer is the server API for TransactionService service.
+// All implementations must embed UnimplementedTransactionServiceServer
+// for forward compatibility
+type TransactionServiceServer interface {
+	PostTransaction(context.Context, *PostTransactionRequest) (*PostTransactionResponse, error)
+	EditTransaction(context.Context, *EditTransactionRequest) (*EditTransactionResponse, error)
+	DeleteTransaction(context.Context, *DeleteTransactionRequest) (*DeleteTransactionResponse, error)
+	ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error)
+	SearchTransactions(context.Context, *SearchTransactionsRequest) (*SearchTransactionsResponse, error)
+	PostGroupTransaction(context.Context, *PostGroupTransactionRequest) (*PostGroupTransactionResponse, error)
+	EditGroupTransaction(context.Context, *EditGroupTransactionRequest) (*EditGroupTransactionResponse, error)
+	DeleteGroupTransaction(context.Context, *DeleteGroupTransactionRequest) (*DeleteGroupTransactionResponse, error)
+	ListGroupTransactions(context.Context, *ListGroupTransactionsRequest) (*ListGroupTransactionsResponse, error)
+	mustEmbedUnimplementedTransactionServiceServer()
+}
+
+// UnimplementedTransactionServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTransactionServiceServer struct {
+}
+
+func (UnimplementedTransactionServiceServer) PostTransaction(context.Context, *PostTransactionRequest) (*PostTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostTransaction not implemented")
+}
+func (UnimplementedTransactionServiceServer) EditTransaction(context.Context, *EditTransactionRequest) (*EditTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditTransaction not implemented")
+}
+func (UnimplementedTransactionServiceServer) DeleteTransaction(context.Context, *DeleteTransactionRequest) (*DeleteTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTransaction not implemented")
+}
+func (UnimplementedTransactionServiceServer) ListTransactions(context.Context, *ListTransactionsRequest) (*ListTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTransactions not implemented")
+}
+func (UnimplementedTransactionServiceServer) SearchTransactions(context.Context, *SearchTransactionsRequest) (*SearchTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchTransactions not implemented")
+}
+func (UnimplementedTransactionServiceServer) PostGroupTransaction(context.Context, *PostGroupTransactionRequest) (*PostGroupTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostGroupTransaction not implemented")
+}
+func (UnimplementedTransactionServiceServer) EditGroupTransaction(context.Context, *EditGroupTransactionRequest) (*EditGroupTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditGroupTransaction not implemented")
+}
+func (UnimplementedTransactionServiceServer) DeleteGroupTransaction(context.Context, *DeleteGroupTransactionRequest) (*DeleteGroupTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGroupTransaction not implemented")
+}
+func (UnimplementedTransactionServiceServer) ListGroupTransactions(context.Context, *ListGroupTransactionsRequest) (*ListGroupTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGroupTransactions not implemented")
+}
+func (UnimplementedTransactionServiceServer) mustEmbedUnimplementedTransactionServiceServer() {}
+
+// UnsafeTransactionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TransactionServiceServer will
+// result in compilation errors.
+type UnsafeTransactionServiceServer interface {
+	mustEmbedUnimplementedTransactionServiceServer()
+}
+
+func RegisterTransactionServiceServer(s grpc.ServiceRegistrar, srv TransactionServiceServer) {
+	s.RegisterService(&TransactionService_ServiceDesc, srv)
+}
+
+func _TransactionService_PostTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).PostTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.TransactionService/PostTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).PostTransaction(ctx, req.(*PostTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_EditTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).EditTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.TransactionService/EditTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).EditTransaction(ctx, req.(*EditTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_DeleteTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).DeleteTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.TransactionService/DeleteTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).DeleteTransaction(ctx, req.(*DeleteTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_ListTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ListTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.TransactionService/ListTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ListTransactions(ctx, req.(*ListTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_SearchTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).SearchTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.TransactionService/SearchTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).SearchTransactions(ctx, req.(*SearchTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_PostGroupTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PostGroupTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).PostGroupTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.TransactionService/PostGroupTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).PostGroupTransaction(ctx, req.(*PostGroupTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_EditGroupTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditGroupTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).EditGroupTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.TransactionService/EditGroupTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).EditGroupTransaction(ctx, req.(*EditGroupTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_DeleteGroupTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGroupTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).DeleteGroupTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.TransactionService/DeleteGroupTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).DeleteGroupTransaction(ctx, req.(*DeleteGroupTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionService_ListGroupTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionServiceServer).ListGroupTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.TransactionService/ListGroupTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionServiceServer).ListGroupTransactions(ctx, req.(*ListGroupTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TransactionService_ServiceDesc is the grpc.ServiceDesc for TransactionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TransactionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "account.TransactionService",
+	HandlerType: (*TransactionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PostTransaction",
+			Handler:    _TransactionService_PostTransaction_Handler,
+		},
+		{
+			MethodName: "EditTransaction",
+			Handler:    _TransactionService_EditTransaction_Handler,
+		},
+		{
+			MethodName: "DeleteTransaction",
+			Handler:    _TransactionService_DeleteTransaction_Handler,
+		},
+		{
+			MethodName: "ListTransactions",
+			Handler:    _TransactionService_ListTransactions_Handler,
+		},
+		{
+			MethodName: "SearchTransactions",
+			Handler:    _TransactionService_SearchTransactions_Handler,
+		},
+		{
+			MethodName: "PostGroupTransaction",
+			Handler:    _TransactionService_PostGroupTransaction_Handler,
+		},
+		{
+			MethodName: "EditGroupTransaction",
+			Handler:    _TransactionService_EditGroupTransaction_Handler,
+		},
+		{
+			MethodName: "DeleteGroupTransaction",
+			Handler:    _TransactionService_DeleteGroupTransaction_Handler,
+		},
+		{
+			MethodName: "ListGroupTransactions",
+			Handler:    _TransactionService_ListGroupTransactions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/accountproto/account.proto",
+}
+
+// RecurringTransactionServiceClient is the client API for RecurringTransactionService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RecurringTransactionServiceClient interface {
+	CreateRecurringTransaction(ctx context.Context, in *CreateRecurringTransactionRequest, opts ...grpc.CallOption) (*CreateRecurringTransactionResponse, error)
+	ListRecurringTransactions(ctx context.Context, in *ListRecurringTransactionsRequest, opts ...grpc.CallOption) (*ListRecurringTransactionsResponse, error)
+	EditRecurringTransaction(ctx context.Context, in *EditRecurringTransactionRequest, opts ...grpc.CallOption) (*EditRecurringTransactionResponse, error)
+	DeleteRecurringTransaction(ctx context.Context, in *DeleteRecurringTransactionRequest, opts ...grpc.CallOption) (*DeleteRecurringTransactionResponse, error)
+	ExecuteRecurringTransactions(ctx context.Context, in *ExecuteRecurringTransactionsRequest, opts ...grpc.CallOption) (*ExecuteRecurringTransactionsResponse, error)
+}
+
+type recurringTransactionServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRecurringTransactionServiceClient(cc grpc.ClientConnInterface) RecurringTransactionServiceClient {
+	return &recurringTransactionServiceClient{cc}
+}
+
+func (c *recurringTransactionServiceClient) CreateRecurringTransaction(ctx context.Context, in *CreateRecurringTransactionRequest, opts ...grpc.CallOption) (*CreateRecurringTransactionResponse, error) {
+	out := new(CreateRecurringTransactionResponse)
+	err := c.cc.Invoke(ctx, "/account.RecurringTransactionService/CreateRecurringTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recurringTransactionServiceClient) ListRecurringTransactions(ctx context.Context, in *ListRecurringTransactionsRequest, opts ...grpc.CallOption) (*ListRecurringTransactionsResponse, error) {
+	out := new(ListRecurringTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/account.RecurringTransactionService/ListRecurringTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recurringTransactionServiceClient) EditRecurringTransaction(ctx context.Context, in *EditRecurringTransactionRequest, opts ...grpc.CallOption) (*EditRecurringTransactionResponse, error) {
+	out := new(EditRecurringTransactionResponse)
+	err := c.cc.Invoke(ctx, "/account.RecurringTransactionService/EditRecurringTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recurringTransactionServiceClient) DeleteRecurringTransaction(ctx context.Context, in *DeleteRecurringTransactionRequest, opts ...grpc.CallOption) (*DeleteRecurringTransactionResponse, error) {
+	out := new(DeleteRecurringTransactionResponse)
+	err := c.cc.Invoke(ctx, "/account.RecurringTransactionService/DeleteRecurringTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *recurringTransactionServiceClient) ExecuteRecurringTransactions(ctx context.Context, in *ExecuteRecurringTransactionsRequest, opts ...grpc.CallOption) (*ExecuteRecurringTransactionsResponse, error) {
+	out := new(ExecuteRecurringTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/account.RecurringTransactionService/ExecuteRecurringTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RecurringTransactionServiceServer is the server API for RecurringTransactionService service.
+// All implementations must embed UnimplementedRecurringTransactionServiceServer
+// for forward compatibility
+type RecurringTransactionServiceServer interface {
+	CreateRecurringTransaction(context.Context, *CreateRecurringTransactionRequest) (*CreateRecurringTransactionResponse, error)
+	ListRecurringTransactions(context.Context, *ListRecurringTransactionsRequest) (*ListRecurringTransactionsResponse, error)
+	EditRecurringTransaction(context.Context, *EditRecurringTransactionRequest) (*EditRecurringTransactionResponse, error)
+	DeleteRecurringTransaction(context.Context, *DeleteRecurringTransactionRequest) (*DeleteRecurringTransactionResponse, error)
+	ExecuteRecurringTransactions(context.Context, *ExecuteRecurringTransactionsRequest) (*ExecuteRecurringTransactionsResponse, error)
+	mustEmbedUnimplementedRecurringTransactionServiceServer()
+}
+
+// UnimplementedRecurringTransactionServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedRecurringTransactionServiceServer struct {
+}
+
+func (UnimplementedRecurringTransactionServiceServer) CreateRecurringTransaction(context.Context, *CreateRecurringTransactionRequest) (*CreateRecurringTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRecurringTransaction not implemented")
+}
+func (UnimplementedRecurringTransactionServiceServer) ListRecurringTransactions(context.Context, *ListRecurringTransactionsRequest) (*ListRecurringTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecurringTransactions not implemented")
+}
+func (UnimplementedRecurringTransactionServiceServer) EditRecurringTransaction(context.Context, *EditRecurringTransactionRequest) (*EditRecurringTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditRecurringTransaction not implemented")
+}
+func (UnimplementedRecurringTransactionServiceServer) DeleteRecurringTransaction(context.Context, *DeleteRecurringTransactionRequest) (*DeleteRecurringTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRecurringTransaction not implemented")
+}
+func (UnimplementedRecurringTransactionServiceServer) ExecuteRecurringTransactions(context.Context, *ExecuteRecurringTransactionsRequest) (*ExecuteRecurringTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteRecurringTransactions not implemented")
+}
+func (UnimplementedRecurringTransactionServiceServer) mustEmbedUnimplementedRecurringTransactionServiceServer() {
+}
+
+// UnsafeRecurringTransactionServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RecurringTransactionServiceServer will
+// result in compilation errors.
+type UnsafeRecurringTransactionServiceServer interface {
+	mustEmbedUnimplementedRecurringTransactionServiceServer()
+}
+
+func RegisterRecurringTransactionServiceServer(s grpc.ServiceRegistrar, srv RecurringTransactionServiceServer) {
+	s.RegisterService(&RecurringTransactionService_ServiceDesc, srv)
+}
+
+func _RecurringTransactionService_CreateRecurringTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRecurringTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecurringTransactionServiceServer).CreateRecurringTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.RecurringTransactionService/CreateRecurringTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecurringTransactionServiceServer).CreateRecurringTransaction(ctx, req.(*CreateRecurringTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecurringTransactionService_ListRecurringTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecurringTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecurringTransactionServiceServer).ListRecurringTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.RecurringTransactionService/ListRecurringTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecurringTransactionServiceServer).ListRecurringTransactions(ctx, req.(*ListRecurringTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecurringTransactionService_EditRecurringTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditRecurringTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecurringTransactionServiceServer).EditRecurringTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.RecurringTransactionService/EditRecurringTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecurringTransactionServiceServer).EditRecurringTransaction(ctx, req.(*EditRecurringTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecurringTransactionService_DeleteRecurringTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRecurringTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecurringTransactionServiceServer).DeleteRecurringTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.RecurringTransactionService/DeleteRecurringTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecurringTransactionServiceServer).DeleteRecurringTransaction(ctx, req.(*DeleteRecurringTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RecurringTransactionService_ExecuteRecurringTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteRecurringTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecurringTransactionServiceServer).ExecuteRecurringTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/account.RecurringTransactionService/ExecuteRecurringTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecurringTransactionServiceServer).ExecuteRecurringTransactions(ctx, req.(*ExecuteRecurringTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RecurringTransactionService_ServiceDesc is the grpc.ServiceDesc for RecurringTransactionService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RecurringTransactionService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "account.RecurringTransactionService",
+	HandlerType: (*RecurringTransactionServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateRecurringTransaction",
+			Handler:    _RecurringTransactionService_CreateRecurringTransaction_Handler,
+		},
+		{
+			MethodName: "ListRecurringTransactions",
+			Handler:    _RecurringTransactionService_ListRecurringTransactions_Handler,
+		},
+		{
+			MethodName: "EditRecurringTransaction",
+			Handler:    _RecurringTransactionService_EditRecurringTransaction_Handler,
+		},
+		{
+			MethodName: "DeleteRecurringTransaction",
+			Handler:    _RecurringTransactionService_DeleteRecurringTransaction_Handler,
+		},
+		{
+			MethodName: "ExecuteRecurringTransactions",
+			Handler:    _RecurringTransactionService_ExecuteRecurringTransactions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/accountproto/account.proto",
@@ -0,0 +1,461 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package todoproto
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// TodoServiceClient is the client API for TodoService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TodoServiceClient interface {
+	CreateTodo(ctx context.Context, in *CreateTodoRequest, opts ...grpc.CallOption) (*CreateTodoResponse, error)
+	EditTodo(ctx context.Context, in *EditTodoRequest, opts ...grpc.CallOption) (*EditTodoResponse, error)
+	DeleteTodo(ctx context.Context, in *DeleteTodoRequest, opts ...grpc.CallOption) (*DeleteTodoResponse, error)
+	ChangeTodoCompletion(ctx context.Context, in *ChangeTodoCompletionRequest, opts ...grpc.CallOption) (*ChangeTodoCompletionResponse, error)
+	ListTodos(ctx context.Context, in *ListTodosRequest, opts ...grpc.CallOption) (*ListTodosResponse, error)
+	CreateShoppingItem(ctx context.Context, in *CreateShoppingItemRequest, opts ...grpc.CallOption) (*CreateShoppingItemResponse, error)
+	EditShoppingItem(ctx context.Context, in *EditShoppingItemRequest, opts ...grpc.CallOption) (*EditShoppingItemResponse, error)
+	DeleteShoppingItem(ctx context.Context, in *DeleteShoppingItemRequest, opts ...grpc.CallOption) (*DeleteShoppingItemResponse, error)
+	ChangeShoppingItemCompletion(ctx context.Context, in *ChangeShoppingItemCompletionRequest, opts ...grpc.CallOption) (*ChangeShoppingItemCompletionResponse, error)
+	ListShoppingItems(ctx context.Context, in *ListShoppingItemsRequest, opts ...grpc.CallOption) (*ListShoppingItemsResponse, error)
+	PurchaseShoppingItem(ctx context.Context, in *PurchaseShoppingItemRequest, opts ...grpc.CallOption) (*PurchaseShoppingItemResponse, error)
+}
+
+type todoServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTodoServiceClient(cc grpc.ClientConnInterface) TodoServiceClient {
+	return &todoServiceClient{cc}
+}
+
+func (c *todoServiceClient) CreateTodo(ctx context.Context, in *CreateTodoRequest, opts ...grpc.CallOption) (*CreateTodoResponse, error) {
+	out := new(CreateTodoResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/CreateTodo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) EditTodo(ctx context.Context, in *EditTodoRequest, opts ...grpc.CallOption) (*EditTodoResponse, error) {
+	out := new(EditTodoResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/EditTodo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) DeleteTodo(ctx context.Context, in *DeleteTodoRequest, opts ...grpc.CallOption) (*DeleteTodoResponse, error) {
+	out := new(DeleteTodoResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/DeleteTodo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) ChangeTodoCompletion(ctx context.Context, in *ChangeTodoCompletionRequest, opts ...grpc.CallOption) (*ChangeTodoCompletionResponse, error) {
+	out := new(ChangeTodoCompletionResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/ChangeTodoCompletion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) ListTodos(ctx context.Context, in *ListTodosRequest, opts ...grpc.CallOption) (*ListTodosResponse, error) {
+	out := new(ListTodosResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/ListTodos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) CreateShoppingItem(ctx context.Context, in *CreateShoppingItemRequest, opts ...grpc.CallOption) (*CreateShoppingItemResponse, error) {
+	out := new(CreateShoppingItemResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/CreateShoppingItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) EditShoppingItem(ctx context.Context, in *EditShoppingItemRequest, opts ...grpc.CallOption) (*EditShoppingItemResponse, error) {
+	out := new(EditShoppingItemResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/EditShoppingItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) DeleteShoppingItem(ctx context.Context, in *DeleteShoppingItemRequest, opts ...grpc.CallOption) (*DeleteShoppingItemResponse, error) {
+	out := new(DeleteShoppingItemResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/DeleteShoppingItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) ChangeShoppingItemCompletion(ctx context.Context, in *ChangeShoppingItemCompletionRequest, opts ...grpc.CallOption) (*ChangeShoppingItemCompletionResponse, error) {
+	out := new(ChangeShoppingItemCompletionResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/ChangeShoppingItemCompletion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) ListShoppingItems(ctx context.Context, in *ListShoppingItemsRequest, opts ...grpc.CallOption) (*ListShoppingItemsResponse, error) {
+	out := new(ListShoppingItemsResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/ListShoppingItems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *todoServiceClient) PurchaseShoppingItem(ctx context.Context, in *PurchaseShoppingItemRequest, opts ...grpc.CallOption) (*PurchaseShoppingItemResponse, error) {
+	out := new(PurchaseShoppingItemResponse)
+	err := c.cc.Invoke(ctx, "/todo.TodoService/PurchaseShoppingItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TodoServiceServer is the server API for TodoService service.
+// All implementations must embed UnimplementedTodoServiceServer
+// for forward compatibility
+type TodoServiceServer interface {
+	CreateTodo(context.Context, *CreateTodoRequest) (*CreateTodoResponse, error)
+	EditTodo(context.Context, *EditTodoRequest) (*EditTodoResponse, error)
+	DeleteTodo(context.Context, *DeleteTodoRequest) (*DeleteTodoResponse, error)
+	ChangeTodoCompletion(context.Context, *ChangeTodoCompletionRequest) (*ChangeTodoCompletionResponse, error)
+	ListTodos(context.Context, *ListTodosRequest) (*ListTodosResponse, error)
+	CreateShoppingItem(context.Context, *CreateShoppingItemRequest) (*CreateShoppingItemResponse, error)
+	EditShoppingItem(context.Context, *EditShoppingItemRequest) (*EditShoppingItemResponse, error)
+	DeleteShoppingItem(context.Context, *DeleteShoppingItemRequest) (*DeleteShoppingItemResponse, error)
+	ChangeShoppingItemCompletion(context.Context, *ChangeShoppingItemCompletionRequest) (*ChangeShoppingItemCompletionResponse, error)
+	ListShoppingItems(context.Context, *ListShoppingItemsRequest) (*ListShoppingItemsResponse, error)
+	PurchaseShoppingItem(context.Context, *PurchaseShoppingItemRequest) (*PurchaseShoppingItemResponse, error)
+	mustEmbedUnimplementedTodoServiceServer()
+}
+
+// UnimplementedTodoServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTodoServiceServer struct {
+}
+
+func (UnimplementedTodoServiceServer) CreateTodo(context.Context, *CreateTodoRequest) (*CreateTodoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTodo not implemented")
+}
+func (UnimplementedTodoServiceServer) EditTodo(context.Context, *EditTodoRequest) (*EditTodoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditTodo not implemented")
+}
+func (UnimplementedTodoServiceServer) DeleteTodo(context.Context, *DeleteTodoRequest) (*DeleteTodoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTodo not implemented")
+}
+func (UnimplementedTodoServiceServer) ChangeTodoCompletion(context.Context, *ChangeTodoCompletionRequest) (*ChangeTodoCompletionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeTodoCompletion not implemented")
+}
+func (UnimplementedTodoServiceServer) ListTodos(context.Context, *ListTodosRequest) (*ListTodosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTodos not implemented")
+}
+func (UnimplementedTodoServiceServer) CreateShoppingItem(context.Context, *CreateShoppingItemRequest) (*CreateShoppingItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateShoppingItem not implemented")
+}
+func (UnimplementedTodoServiceServer) EditShoppingItem(context.Context, *EditShoppingItemRequest) (*EditShoppingItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditShoppingItem not implemented")
+}
+func (UnimplementedTodoServiceServer) DeleteShoppingItem(context.Context, *DeleteShoppingItemRequest) (*DeleteShoppingItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteShoppingItem not implemented")
+}
+func (UnimplementedTodoServiceServer) ChangeShoppingItemCompletion(context.Context, *ChangeShoppingItemCompletionRequest) (*ChangeShoppingItemCompletionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeShoppingItemCompletion not implemented")
+}
+func (UnimplementedTodoServiceServer) ListShoppingItems(context.Context, *ListShoppingItemsRequest) (*ListShoppingItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListShoppingItems not implemented")
+}
+func (UnimplementedTodoServiceServer) PurchaseShoppingItem(context.Context, *PurchaseShoppingItemRequest) (*PurchaseShoppingItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurchaseShoppingItem not implemented")
+}
+func (UnimplementedTodoServiceServer) mustEmbedUnimplementedTodoServiceServer() {}
+
+// UnsafeTodoServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TodoServiceServer will
+// result in compilation errors.
+type UnsafeTodoServiceServer interface {
+	mustEmbedUnimplementedTodoServiceServer()
+}
+
+func RegisterTodoServiceServer(s grpc.ServiceRegistrar, srv TodoServiceServer) {
+	s.RegisterService(&TodoService_ServiceDesc, srv)
+}
+
+func _TodoService_CreateTodo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTodoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).CreateTodo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/CreateTodo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).CreateTodo(ctx, req.(*CreateTodoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_EditTodo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditTodoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).EditTodo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/EditTodo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).EditTodo(ctx, req.(*EditTodoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_DeleteTodo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTodoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).DeleteTodo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/DeleteTodo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).DeleteTodo(ctx, req.(*DeleteTodoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_ChangeTodoCompletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeTodoCompletionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).ChangeTodoCompletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/ChangeTodoCompletion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).ChangeTodoCompletion(ctx, req.(*ChangeTodoCompletionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_ListTodos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTodosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).ListTodos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/ListTodos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).ListTodos(ctx, req.(*ListTodosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_CreateShoppingItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShoppingItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).CreateShoppingItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/CreateShoppingItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).CreateShoppingItem(ctx, req.(*CreateShoppingItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_EditShoppingItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditShoppingItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).EditShoppingItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/EditShoppingItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).EditShoppingItem(ctx, req.(*EditShoppingItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_DeleteShoppingItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteShoppingItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).DeleteShoppingItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/DeleteShoppingItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).DeleteShoppingItem(ctx, req.(*DeleteShoppingItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_ChangeShoppingItemCompletion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeShoppingItemCompletionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).ChangeShoppingItemCompletion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/ChangeShoppingItemCompletion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).ChangeShoppingItemCompletion(ctx, req.(*ChangeShoppingItemCompletionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_ListShoppingItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShoppingItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).ListShoppingItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/ListShoppingItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).ListShoppingItems(ctx, req.(*ListShoppingItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TodoService_PurchaseShoppingItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurchaseShoppingItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TodoServiceServer).PurchaseShoppingItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/todo.TodoService/PurchaseShoppingItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TodoServiceServer).PurchaseShoppingItem(ctx, req.(*PurchaseShoppingItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TodoService_ServiceDesc is the grpc.ServiceDesc for TodoService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TodoService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "todo.TodoService",
+	HandlerType: (*TodoServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTodo",
+			Handler:    _TodoService_CreateTodo_Handler,
+		},
+		{
+			MethodName: "EditTodo",
+			Handler:    _TodoService_EditTodo_Handler,
+		},
+		{
+			MethodName: "DeleteTodo",
+			Handler:    _TodoService_DeleteTodo_Handler,
+		},
+		{
+			MethodName: "ChangeTodoCompletion",
+			Handler:    _TodoService_ChangeTodoCompletion_Handler,
+		},
+		{
+			MethodName: "ListTodos",
+			Handler:    _TodoService_ListTodos_Handler,
+		},
+		{
+			MethodName: "CreateShoppingItem",
+			Handler:    _TodoService_CreateShoppingItem_Handler,
+		},
+		{
+			MethodName: "EditShoppingItem",
+			Handler:    _TodoService_EditShoppingItem_Handler,
+		},
+		{
+			MethodName: "DeleteShoppingItem",
+			Handler:    _TodoService_DeleteShoppingItem_Handler,
+		},
+		{
+			MethodName: "ChangeShoppingItemCompletion",
+			Handler:    _TodoService_ChangeShoppingItemCompletion_Handler,
+		},
+		{
+			MethodName: "ListShoppingItems",
+			Handler:    _TodoService_ListShoppingItems_Handler,
+		},
+		{
+			MethodName: "PurchaseShoppingItem",
+			Handler:    _TodoService_PurchaseShoppingItem_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/todoproto/todo.proto",
+}